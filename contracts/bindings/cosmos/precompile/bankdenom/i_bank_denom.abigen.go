@@ -0,0 +1,880 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bankdenom
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CosmosCoin is an auto generated low-level Go binding around an user-defined struct.
+type CosmosCoin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+// BankDenomMetaData contains all meta data concerning the BankDenom contract.
+var BankDenomMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"denom\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transfer\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CoinReceived\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CoinSpent\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Message\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InsufficientFunds\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]",
+}
+
+// BankDenomABI is the input ABI used to generate the binding from.
+// Deprecated: Use BankDenomMetaData.ABI instead.
+var BankDenomABI = BankDenomMetaData.ABI
+
+// BankDenom is an auto generated Go binding around an Ethereum contract.
+type BankDenom struct {
+	BankDenomCaller     // Read-only binding to the contract
+	BankDenomTransactor // Write-only binding to the contract
+	BankDenomFilterer   // Log filterer for contract events
+}
+
+// BankDenomCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BankDenomCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankDenomTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BankDenomTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankDenomFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BankDenomFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankDenomSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BankDenomSession struct {
+	Contract     *BankDenom        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BankDenomCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BankDenomCallerSession struct {
+	Contract *BankDenomCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// BankDenomTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BankDenomTransactorSession struct {
+	Contract     *BankDenomTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// BankDenomRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BankDenomRaw struct {
+	Contract *BankDenom // Generic contract binding to access the raw methods on
+}
+
+// BankDenomCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BankDenomCallerRaw struct {
+	Contract *BankDenomCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BankDenomTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BankDenomTransactorRaw struct {
+	Contract *BankDenomTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBankDenom creates a new instance of BankDenom, bound to a specific deployed contract.
+func NewBankDenom(address common.Address, backend bind.ContractBackend) (*BankDenom, error) {
+	contract, err := bindBankDenom(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenom{BankDenomCaller: BankDenomCaller{contract: contract}, BankDenomTransactor: BankDenomTransactor{contract: contract}, BankDenomFilterer: BankDenomFilterer{contract: contract}}, nil
+}
+
+// NewBankDenomCaller creates a new read-only instance of BankDenom, bound to a specific deployed contract.
+func NewBankDenomCaller(address common.Address, caller bind.ContractCaller) (*BankDenomCaller, error) {
+	contract, err := bindBankDenom(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomCaller{contract: contract}, nil
+}
+
+// NewBankDenomTransactor creates a new write-only instance of BankDenom, bound to a specific deployed contract.
+func NewBankDenomTransactor(address common.Address, transactor bind.ContractTransactor) (*BankDenomTransactor, error) {
+	contract, err := bindBankDenom(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomTransactor{contract: contract}, nil
+}
+
+// NewBankDenomFilterer creates a new log filterer instance of BankDenom, bound to a specific deployed contract.
+func NewBankDenomFilterer(address common.Address, filterer bind.ContractFilterer) (*BankDenomFilterer, error) {
+	contract, err := bindBankDenom(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomFilterer{contract: contract}, nil
+}
+
+// bindBankDenom binds a generic wrapper to an already deployed contract.
+func bindBankDenom(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BankDenomMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BankDenom *BankDenomRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BankDenom.Contract.BankDenomCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BankDenom *BankDenomRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BankDenom.Contract.BankDenomTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BankDenom *BankDenomRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BankDenom.Contract.BankDenomTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BankDenom *BankDenomCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BankDenom.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BankDenom *BankDenomTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BankDenom.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BankDenom *BankDenomTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BankDenom.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_BankDenom *BankDenomCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BankDenom.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_BankDenom *BankDenomSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _BankDenom.Contract.BalanceOf(&_BankDenom.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_BankDenom *BankDenomCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _BankDenom.Contract.BalanceOf(&_BankDenom.CallOpts, account)
+}
+
+// Denom is a free data retrieval call binding the contract method 0xc370b042.
+//
+// Solidity: function denom() view returns(string)
+func (_BankDenom *BankDenomCaller) Denom(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BankDenom.contract.Call(opts, &out, "denom")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Denom is a free data retrieval call binding the contract method 0xc370b042.
+//
+// Solidity: function denom() view returns(string)
+func (_BankDenom *BankDenomSession) Denom() (string, error) {
+	return _BankDenom.Contract.Denom(&_BankDenom.CallOpts)
+}
+
+// Denom is a free data retrieval call binding the contract method 0xc370b042.
+//
+// Solidity: function denom() view returns(string)
+func (_BankDenom *BankDenomCallerSession) Denom() (string, error) {
+	return _BankDenom.Contract.Denom(&_BankDenom.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BankDenom *BankDenomCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BankDenom.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BankDenom *BankDenomSession) TotalSupply() (*big.Int, error) {
+	return _BankDenom.Contract.TotalSupply(&_BankDenom.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BankDenom *BankDenomCallerSession) TotalSupply() (*big.Int, error) {
+	return _BankDenom.Contract.TotalSupply(&_BankDenom.CallOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_BankDenom *BankDenomTransactor) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankDenom.contract.Transact(opts, "transfer", to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_BankDenom *BankDenomSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankDenom.Contract.Transfer(&_BankDenom.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_BankDenom *BankDenomTransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankDenom.Contract.Transfer(&_BankDenom.TransactOpts, to, amount)
+}
+
+// BankDenomCoinReceivedIterator is returned from FilterCoinReceived and is used to iterate over the raw logs and unpacked data for CoinReceived events raised by the BankDenom contract.
+type BankDenomCoinReceivedIterator struct {
+	Event *BankDenomCoinReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankDenomCoinReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankDenomCoinReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankDenomCoinReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankDenomCoinReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankDenomCoinReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankDenomCoinReceived represents a CoinReceived event raised by the BankDenom contract.
+type BankDenomCoinReceived struct {
+	Receiver common.Address
+	Amount   []CosmosCoin
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterCoinReceived is a free log retrieval operation binding the contract event 0x13f9c352919df1623a08e6d6d9eac5f774573896f09916d8fbc5d083095fc3b4.
+//
+// Solidity: event CoinReceived(address indexed receiver, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) FilterCoinReceived(opts *bind.FilterOpts, receiver []common.Address) (*BankDenomCoinReceivedIterator, error) {
+
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.FilterLogs(opts, "CoinReceived", receiverRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomCoinReceivedIterator{contract: _BankDenom.contract, event: "CoinReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchCoinReceived is a free log subscription operation binding the contract event 0x13f9c352919df1623a08e6d6d9eac5f774573896f09916d8fbc5d083095fc3b4.
+//
+// Solidity: event CoinReceived(address indexed receiver, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) WatchCoinReceived(opts *bind.WatchOpts, sink chan<- *BankDenomCoinReceived, receiver []common.Address) (event.Subscription, error) {
+
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.WatchLogs(opts, "CoinReceived", receiverRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankDenomCoinReceived)
+				if err := _BankDenom.contract.UnpackLog(event, "CoinReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCoinReceived is a log parse operation binding the contract event 0x13f9c352919df1623a08e6d6d9eac5f774573896f09916d8fbc5d083095fc3b4.
+//
+// Solidity: event CoinReceived(address indexed receiver, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) ParseCoinReceived(log types.Log) (*BankDenomCoinReceived, error) {
+	event := new(BankDenomCoinReceived)
+	if err := _BankDenom.contract.UnpackLog(event, "CoinReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BankDenomCoinSpentIterator is returned from FilterCoinSpent and is used to iterate over the raw logs and unpacked data for CoinSpent events raised by the BankDenom contract.
+type BankDenomCoinSpentIterator struct {
+	Event *BankDenomCoinSpent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankDenomCoinSpentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankDenomCoinSpent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankDenomCoinSpent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankDenomCoinSpentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankDenomCoinSpentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankDenomCoinSpent represents a CoinSpent event raised by the BankDenom contract.
+type BankDenomCoinSpent struct {
+	Spender common.Address
+	Amount  []CosmosCoin
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterCoinSpent is a free log retrieval operation binding the contract event 0x8b8b22fea5b121b174e6cfea34ddaf187b66b43dab67679fa291a0fae2427a99.
+//
+// Solidity: event CoinSpent(address indexed spender, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) FilterCoinSpent(opts *bind.FilterOpts, spender []common.Address) (*BankDenomCoinSpentIterator, error) {
+
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.FilterLogs(opts, "CoinSpent", spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomCoinSpentIterator{contract: _BankDenom.contract, event: "CoinSpent", logs: logs, sub: sub}, nil
+}
+
+// WatchCoinSpent is a free log subscription operation binding the contract event 0x8b8b22fea5b121b174e6cfea34ddaf187b66b43dab67679fa291a0fae2427a99.
+//
+// Solidity: event CoinSpent(address indexed spender, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) WatchCoinSpent(opts *bind.WatchOpts, sink chan<- *BankDenomCoinSpent, spender []common.Address) (event.Subscription, error) {
+
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.WatchLogs(opts, "CoinSpent", spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankDenomCoinSpent)
+				if err := _BankDenom.contract.UnpackLog(event, "CoinSpent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCoinSpent is a log parse operation binding the contract event 0x8b8b22fea5b121b174e6cfea34ddaf187b66b43dab67679fa291a0fae2427a99.
+//
+// Solidity: event CoinSpent(address indexed spender, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) ParseCoinSpent(log types.Log) (*BankDenomCoinSpent, error) {
+	event := new(BankDenomCoinSpent)
+	if err := _BankDenom.contract.UnpackLog(event, "CoinSpent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BankDenomMessageIterator is returned from FilterMessage and is used to iterate over the raw logs and unpacked data for Message events raised by the BankDenom contract.
+type BankDenomMessageIterator struct {
+	Event *BankDenomMessage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankDenomMessageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankDenomMessage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankDenomMessage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankDenomMessageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankDenomMessageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankDenomMessage represents a Message event raised by the BankDenom contract.
+type BankDenomMessage struct {
+	Sender common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessage is a free log retrieval operation binding the contract event 0x516772d06520d23d2705f0b46a1fa6deec0ae36a2c00db049bd5f4094a123b85.
+//
+// Solidity: event Message(address indexed sender)
+func (_BankDenom *BankDenomFilterer) FilterMessage(opts *bind.FilterOpts, sender []common.Address) (*BankDenomMessageIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.FilterLogs(opts, "Message", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomMessageIterator{contract: _BankDenom.contract, event: "Message", logs: logs, sub: sub}, nil
+}
+
+// WatchMessage is a free log subscription operation binding the contract event 0x516772d06520d23d2705f0b46a1fa6deec0ae36a2c00db049bd5f4094a123b85.
+//
+// Solidity: event Message(address indexed sender)
+func (_BankDenom *BankDenomFilterer) WatchMessage(opts *bind.WatchOpts, sink chan<- *BankDenomMessage, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.WatchLogs(opts, "Message", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankDenomMessage)
+				if err := _BankDenom.contract.UnpackLog(event, "Message", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessage is a log parse operation binding the contract event 0x516772d06520d23d2705f0b46a1fa6deec0ae36a2c00db049bd5f4094a123b85.
+//
+// Solidity: event Message(address indexed sender)
+func (_BankDenom *BankDenomFilterer) ParseMessage(log types.Log) (*BankDenomMessage, error) {
+	event := new(BankDenomMessage)
+	if err := _BankDenom.contract.UnpackLog(event, "Message", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BankDenomTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the BankDenom contract.
+type BankDenomTransferIterator struct {
+	Event *BankDenomTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankDenomTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankDenomTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankDenomTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankDenomTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankDenomTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankDenomTransfer represents a Transfer event raised by the BankDenom contract.
+type BankDenomTransfer struct {
+	Recipient common.Address
+	Amount    []CosmosCoin
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0x65ff5b103f0567c3e1783dc0b40e725544567fb6f584d9b084abea2e26d20328.
+//
+// Solidity: event Transfer(address indexed recipient, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) FilterTransfer(opts *bind.FilterOpts, recipient []common.Address) (*BankDenomTransferIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.FilterLogs(opts, "Transfer", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankDenomTransferIterator{contract: _BankDenom.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0x65ff5b103f0567c3e1783dc0b40e725544567fb6f584d9b084abea2e26d20328.
+//
+// Solidity: event Transfer(address indexed recipient, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *BankDenomTransfer, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _BankDenom.contract.WatchLogs(opts, "Transfer", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankDenomTransfer)
+				if err := _BankDenom.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0x65ff5b103f0567c3e1783dc0b40e725544567fb6f584d9b084abea2e26d20328.
+//
+// Solidity: event Transfer(address indexed recipient, (uint256,string)[] amount)
+func (_BankDenom *BankDenomFilterer) ParseTransfer(log types.Log) (*BankDenomTransfer, error) {
+	event := new(BankDenomTransfer)
+	if err := _BankDenom.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -145,7 +145,7 @@ type IGovernanceModuleWeightedVoteOption struct {
 
 // GovernanceModuleMetaData contains all meta data concerning the GovernanceModule contract.
 var GovernanceModuleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"function\",\"name\":\"cancelProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getConstitution\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDepositParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.DepositParams\",\"components\":[{\"name\":\"minDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"maxDepositPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Params\",\"components\":[{\"name\":\"minDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"maxDepositPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"quorum\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"threshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"vetoThreshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"minInitialDepositRatio\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposalCancelRatio\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposalCancelDest\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expeditedVotingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"expeditedThreshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expeditedMinDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"burnVoteQuorum\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"burnProposalDepositPrevote\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"burnVoteVeto\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Proposal\",\"components\":[{\"name\":\"id\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"status\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"finalTallyResult\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"submitTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"totalDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"votingStartTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalDeposits\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalDepositsByDepositor\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositor\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalTallyResult\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalVotes\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"pagination\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageRequest\",\"components\":[{\"name\":\"key\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"offset\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"limit\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"countTotal\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reverse\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.Vote[]\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageResponse\",\"components\":[{\"name\":\"nextKey\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"total\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalVotesByVoter\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Vote\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposals\",\"inputs\":[{\"name\":\"proposalStatus\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"pagination\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageRequest\",\"components\":[{\"name\":\"key\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"offset\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"limit\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"countTotal\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reverse\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.Proposal[]\",\"components\":[{\"name\":\"id\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"status\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"finalTallyResult\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"submitTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"totalDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"votingStartTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageResponse\",\"components\":[{\"name\":\"nextKey\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"total\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTallyParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyParams\",\"components\":[{\"name\":\"quorum\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"threshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"vetoThreshold\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getVotingParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.VotingParams\",\"components\":[{\"name\":\"votingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"submitProposal\",\"inputs\":[{\"name\":\"proposal\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.MsgSubmitProposal\",\"components\":[{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"initialDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expedited\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vote\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"option\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"voteWeighted\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CancelProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalDeposit\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"amount\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalSubmitted\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"proposalSender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalVoted\",\"inputs\":[{\"name\":\"proposalVote\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structIGovernanceModule.Vote\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false}]",
+	ABI: "[{\"type\":\"function\",\"name\":\"cancelProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getConstitution\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDepositParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.DepositParams\",\"components\":[{\"name\":\"minDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"maxDepositPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Params\",\"components\":[{\"name\":\"minDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"maxDepositPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"quorum\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"threshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"vetoThreshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"minInitialDepositRatio\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposalCancelRatio\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposalCancelDest\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expeditedVotingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"expeditedThreshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expeditedMinDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"burnVoteQuorum\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"burnProposalDepositPrevote\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"burnVoteVeto\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Proposal\",\"components\":[{\"name\":\"id\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"status\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"finalTallyResult\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"submitTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"totalDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"votingStartTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalDeposits\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalDepositsByDepositor\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositor\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalTallyResult\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalVotes\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"pagination\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageRequest\",\"components\":[{\"name\":\"key\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"offset\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"limit\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"countTotal\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reverse\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.Vote[]\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageResponse\",\"components\":[{\"name\":\"nextKey\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"total\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposalVotesByVoter\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.Vote\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposals\",\"inputs\":[{\"name\":\"proposalStatus\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"pagination\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageRequest\",\"components\":[{\"name\":\"key\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"offset\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"limit\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"countTotal\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reverse\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.Proposal[]\",\"components\":[{\"name\":\"id\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"status\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"finalTallyResult\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyResult\",\"components\":[{\"name\":\"yesCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"abstainCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noCount\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"noWithVetoCount\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"submitTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"depositEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"totalDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"votingStartTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"votingEndTime\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structCosmos.PageResponse\",\"components\":[{\"name\":\"nextKey\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"total\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTallyParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.TallyParams\",\"components\":[{\"name\":\"quorum\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"threshold\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"vetoThreshold\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getVotingParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.VotingParams\",\"components\":[{\"name\":\"votingPeriod\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"submitProposal\",\"inputs\":[{\"name\":\"proposal\",\"type\":\"tuple\",\"internalType\":\"structIGovernanceModule.MsgSubmitProposal\",\"components\":[{\"name\":\"messages\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.CodecAny[]\",\"components\":[{\"name\":\"typeURL\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"initialDeposit\",\"type\":\"tuple[]\",\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"proposer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"title\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"summary\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"expedited\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vote\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"option\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"voteWeighted\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CancelProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalDeposit\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"amount\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"components\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalSubmitted\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"proposalSender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProposalVoted\",\"inputs\":[{\"name\":\"proposalVote\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structIGovernanceModule.Vote\",\"components\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"options\",\"type\":\"tuple[]\",\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"components\":[{\"name\":\"voteOption\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"weight\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"name\":\"metadata\",\"type\":\"string\",\"internalType\":\"string\"}]}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InactiveProposal\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]},{\"type\":\"error\",\"name\":\"ProposalNotFound\",\"inputs\":[{\"name\":\"proposalId\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}]",
 }
 
 // GovernanceModuleABI is the input ABI used to generate the binding from.
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// boundMethod is an ABI method bound to the Go method of the precompile interface.
+type boundMethod struct {
+	// Name is the name of the ABI method, which keys its dispatcher.
+	Name string
+	// Sig is the signature of the ABI method.
+	Sig string
+	// GoName is the name of the Go method.
+	GoName string
+	// Inputs are the Go parameters of the method, without the context.
+	Inputs []boundArg
+	// Outputs are the Go types of the return values of the method, without the error.
+	Outputs []string
+}
+
+// boundArg is an ABI argument bound to a Go parameter.
+type boundArg struct {
+	Name string
+	Type string
+	// Tuple is true if the argument is or contains a tuple. The unpacked value of a tuple is an
+	// anonymous struct, which must be converted to the struct generated by abigen, whereas the
+	// unpacked values of all other types already have the Go type of the parameter.
+	Tuple bool
+}
+
+// generate returns the formatted Go code of the precompile interface and dispatchers of the
+// given ABI.
+func generate(abiJSON io.Reader, pkg, typ string) ([]byte, error) {
+	parsed, err := abi.JSON(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	methods := make([]boundMethod, 0, len(names))
+	for _, name := range names {
+		var bm boundMethod
+		if bm, err = bindMethod(parsed.Methods[name]); err != nil {
+			return nil, err
+		}
+		methods = append(methods, bm)
+	}
+
+	var buf bytes.Buffer
+	if err = dispatchTemplate.Execute(&buf, map[string]any{
+		"Package": pkg,
+		"Type":    typ,
+		"Methods": methods,
+	}); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// bindMethod binds the given ABI method to a Go method, following the naming of abigen.
+func bindMethod(method abi.Method) (boundMethod, error) {
+	bm := boundMethod{
+		Name:   method.Name,
+		Sig:    method.Sig,
+		GoName: abi.ToCamelCase(method.Name),
+	}
+	for i, input := range method.Inputs {
+		typ, err := bindType(input.Type)
+		if err != nil {
+			return boundMethod{}, fmt.Errorf("%s: %w", method.Sig, err)
+		}
+		bm.Inputs = append(bm.Inputs, boundArg{
+			Name: argName(input.Name, i), Type: typ, Tuple: hasTuple(input.Type),
+		})
+	}
+	for _, output := range method.Outputs {
+		typ, err := bindType(output.Type)
+		if err != nil {
+			return boundMethod{}, fmt.Errorf("%s: %w", method.Sig, err)
+		}
+		bm.Outputs = append(bm.Outputs, typ)
+	}
+	return bm, nil
+}
+
+// bindType returns the Go type of the given ABI type, which is the same type that abigen binds
+// it to. Tuples are bound to the structs generated by abigen, so they must be named.
+func bindType(t abi.Type) (string, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		prefix := "int"
+		if t.T == abi.UintTy {
+			prefix = "uint"
+		}
+		switch t.Size {
+		case 8, 16, 32, 64: //nolint:gomnd // native integer sizes.
+			return fmt.Sprintf("%s%d", prefix, t.Size), nil
+		default:
+			return "*big.Int", nil
+		}
+	case abi.BoolTy:
+		return "bool", nil
+	case abi.StringTy:
+		return "string", nil
+	case abi.AddressTy:
+		return "common.Address", nil
+	case abi.BytesTy:
+		return "[]byte", nil
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size), nil
+	case abi.HashTy:
+		return "common.Hash", nil
+	case abi.FunctionTy:
+		return "[24]byte", nil
+	case abi.SliceTy, abi.ArrayTy:
+		elem, err := bindType(*t.Elem)
+		if err != nil {
+			return "", err
+		}
+		if t.T == abi.SliceTy {
+			return "[]" + elem, nil
+		}
+		return fmt.Sprintf("[%d]%s", t.Size, elem), nil
+	case abi.TupleTy:
+		if t.TupleRawName == "" {
+			return "", fmt.Errorf("tuple %s has no struct name", t.String())
+		}
+		return abi.ToCamelCase(t.TupleRawName), nil
+	default:
+		return "", fmt.Errorf("unsupported ABI type %s", t.String())
+	}
+}
+
+// hasTuple returns true if the given ABI type is a tuple or a slice or array of tuples.
+func hasTuple(t abi.Type) bool {
+	switch t.T {
+	case abi.TupleTy:
+		return true
+	case abi.SliceTy, abi.ArrayTy:
+		return hasTuple(*t.Elem)
+	default:
+		return false
+	}
+}
+
+// argName returns the Go parameter name of the ABI argument at the given index, which must not
+// collide with the context parameter or Go keywords.
+func argName(name string, index int) string {
+	switch {
+	case name == "":
+		return fmt.Sprintf("arg%d", index)
+	case name == "ctx" || token.IsKeyword(name):
+		return name + "Arg"
+	default:
+		return strings.ToLower(name[:1]) + name[1:]
+	}
+}
+
+// dispatchTemplate is the template of the generated Go code.
+//
+//nolint:lll // template of generated code.
+var dispatchTemplate = template.Must(template.New("dispatch").Parse(`
+// Code generated by precompilegen - DO NOT EDIT.
+// This file is a generated precompile dispatcher and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+	_ = abi.ConvertType
+)
+
+// {{.Type}}Precompile is the Go interface of the methods of the {{.Type}} stateful precompile.
+type {{.Type}}Precompile interface {
+{{- range .Methods}}
+	// {{.GoName}} implements the ` + "`{{.Sig}}`" + ` method.
+	{{.GoName}}(ctx context.Context{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) ({{range .Outputs}}{{.}}, {{end}}error)
+{{- end}}
+}
+
+// {{.Type}}Dispatchers returns the dispatchers of the methods of the {{.Type}} stateful
+// precompile, which run the methods of the given implementation without reflection.
+func {{.Type}}Dispatchers(impl {{.Type}}Precompile) map[string]func(context.Context, []any) ([]any, error) {
+	return map[string]func(context.Context, []any) ([]any, error){
+{{- range .Methods}}
+		"{{.Name}}": func(ctx context.Context, {{if .Inputs}}args{{else}}_{{end}} []any) ([]any, error) {
+			{{if .Outputs}}{{range $i, $_ := .Outputs}}out{{$i}}, {{end}}err := {{else}}return nil, {{end}}impl.{{.GoName}}(
+				ctx,
+{{- range $i, $in := .Inputs}}
+				{{if $in.Tuple}}*abi.ConvertType(args[{{$i}}], new({{$in.Type}})).(*{{$in.Type}}){{else}}args[{{$i}}].({{$in.Type}}){{end}},
+{{- end}}
+			)
+{{- if .Outputs}}
+			return []any{ {{- range $i, $_ := .Outputs}}{{if $i}}, {{end}}out{{$i}}{{end -}} }, err
+{{- end}}
+		},
+{{- end}}
+	}
+}
+`))
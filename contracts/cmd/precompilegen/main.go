@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Command precompilegen generates the typed Go interface of the methods of a stateful precompile,
+// and the dispatchers that run them without reflection, from the ABI of the precompile's Solidity
+// interface. It is run next to abigen, which generates the structs of the ABI in the same package:
+//
+//	precompilegen --abi ./out/Bank.sol/IBankModule.abi.json --pkg bank --type BankModule \
+//		--out ./bindings/cosmos/precompile/bank/i_bank_module.dispatch.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	var (
+		abiPath = flag.String("abi", "", "path to the ABI JSON of the Solidity interface")
+		pkg     = flag.String("pkg", "", "Go package name of the generated code")
+		typ     = flag.String("type", "", "Go type name of the precompile contract")
+		out     = flag.String("out", "", "output file of the generated code")
+	)
+	flag.Parse()
+	if *abiPath == "" || *pkg == "" || *typ == "" || *out == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if err := run(*abiPath, *pkg, *typ, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "precompilegen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the code for the ABI at the given path and writes it to the output file.
+func run(abiPath, pkg, typ, out string) error {
+	abiJSON, err := os.Open(abiPath)
+	if err != nil {
+		return err
+	}
+	defer abiJSON.Close()
+
+	code, err := generate(abiJSON, pkg, typ)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, code, 0o600)
+}
@@ -26,9 +26,15 @@
 package contracts
 
 //go:generate abigen --pkg staking --abi ./out/Staking.sol/IStakingModule.abi.json --bin ./out/Staking.sol/IStakingModule.bin --out ./bindings/cosmos/precompile/staking/i_staking_module.abigen.go --type StakingModule
+//go:generate go run ./cmd/precompilegen --abi ./out/Staking.sol/IStakingModule.abi.json --pkg staking --type StakingModule --out ./bindings/cosmos/precompile/staking/i_staking_module.dispatch.go
 //go:generate abigen --pkg bank --abi ./out/Bank.sol/IBankModule.abi.json --bin ./out/Bank.sol/IBankModule.bin --out ./bindings/cosmos/precompile/bank/i_bank_module.abigen.go --type BankModule
+//go:generate go run ./cmd/precompilegen --abi ./out/Bank.sol/IBankModule.abi.json --pkg bank --type BankModule --out ./bindings/cosmos/precompile/bank/i_bank_module.dispatch.go
+//go:generate abigen --pkg bankdenom --abi ./out/BankDenom.sol/IBankDenom.abi.json --bin ./out/BankDenom.sol/IBankDenom.bin --out ./bindings/cosmos/precompile/bankdenom/i_bank_denom.abigen.go --type BankDenom
+//go:generate go run ./cmd/precompilegen --abi ./out/BankDenom.sol/IBankDenom.abi.json --pkg bankdenom --type BankDenom --out ./bindings/cosmos/precompile/bankdenom/i_bank_denom.dispatch.go
 //go:generate abigen --pkg distribution --abi ./out/Distribution.sol/IDistributionModule.abi.json --bin ./out/Distribution.sol/IDistributionModule.bin --out ./bindings/cosmos/precompile/distribution/i_distribution_module.abigen.go --type DistributionModule --exc "IBankModuleCoin"
+//go:generate go run ./cmd/precompilegen --abi ./out/Distribution.sol/IDistributionModule.abi.json --pkg distribution --type DistributionModule --out ./bindings/cosmos/precompile/distribution/i_distribution_module.dispatch.go
 //go:generate abigen --pkg governance --abi ./out/Governance.sol/IGovernanceModule.abi.json --bin ./out/Governance.sol/IGovernanceModule.bin --out ./bindings/cosmos/precompile/governance/i_governance_module.abigen.go --type GovernanceModule
+//go:generate go run ./cmd/precompilegen --abi ./out/Governance.sol/IGovernanceModule.abi.json --pkg governance --type GovernanceModule --out ./bindings/cosmos/precompile/governance/i_governance_module.dispatch.go
 //go:generate abigen --pkg lib --abi ./out/CosmosTypes.sol/CosmosTypes.abi.json --bin ./out/CosmosTypes.sol/CosmosTypes.bin --out ./bindings/cosmos/lib/cosmos_types.abigen.go --type CosmosTypes
 //go:generate abigen --pkg testing --abi ./out/SolmateERC20.sol/SolmateERC20.abi.json --bin ./out/SolmateERC20.sol/SolmateERC20.bin --out ./bindings/testing/solmate_erc20.abigen.go --type SolmateERC20
 //go:generate abigen --pkg testing --abi ./out/MockPrecompileInterface.sol/MockPrecompileInterface.abi.json --out ./bindings/testing/mock_precompile_interface.abigen.go --type MockPrecompile
@@ -31,6 +31,15 @@ import {Cosmos} from "../CosmosTypes.sol";
  * @dev Interface of all supported Cosmos events emitted by the bank module
  */
 interface IBankModule {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Returned when `accountAddress` does not hold enough coins to send `amount`
+     * @param accountAddress The sender address
+     * @param amount The amount of Cosmos coins to send
+     */
+    error InsufficientFunds(address accountAddress, Cosmos.Coin[] amount);
+
     ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
 
     /**
@@ -121,7 +130,7 @@ interface IBankModule {
      * @param amount The amount of Cosmos coins to send
      * @notice If the sender does not have enough balance, returns false
      */
-    function send(address toAddress, Cosmos.Coin[] calldata amount) external payable returns (bool);
+    function send(address toAddress, Cosmos.Coin[] calldata amount) external returns (bool);
 
     //////////////////////////////////////////// UTILS ////////////////////////////////////////////
 
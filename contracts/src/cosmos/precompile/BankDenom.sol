@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity 0.8.23;
+
+import {Cosmos} from "../CosmosTypes.sol";
+
+/**
+ * @dev Interface of the dynamic precompiles of the bank module, which are instantiated by
+ * governance for a single coin denomination each
+ */
+interface IBankDenom {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Returned when `account` does not hold enough of the coin to transfer `amount`
+     * @param account The sender address
+     * @param amount The amount of the coin to transfer
+     */
+    error InsufficientFunds(address account, uint256 amount);
+
+    ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
+
+    /**
+     * @dev Emitted by the bank module when `amount` tokens are sent to `recipient`
+     * @param recipient The recipient address
+     * @param amount The amount of Cosmos coins sent
+     */
+    event Transfer(address indexed recipient, Cosmos.Coin[] amount);
+
+    /**
+     * @dev Emitted by the bank module when `sender` sends some amount of tokens
+     * @param sender The sender address
+     */
+    event Message(address indexed sender);
+
+    /**
+     * @dev Emitted by the bank module when `amount` tokens are spent by `spender`
+     * @param spender The spender address
+     * @param amount The amount of Cosmos coins spent
+     */
+    event CoinSpent(address indexed spender, Cosmos.Coin[] amount);
+
+    /**
+     * @dev Emitted by the bank module when `amount` tokens are received by `receiver`
+     * @param receiver The receiver address
+     * @param amount The amount of Cosmos coins received
+     */
+    event CoinReceived(address indexed receiver, Cosmos.Coin[] amount);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns the coin denomination of the precompile
+     */
+    function denom() external view returns (string memory);
+
+    /**
+     * @dev Returns the total supply of the coin
+     */
+    function totalSupply() external view returns (uint256);
+
+    /**
+     * @dev Returns the `amount` of account balance by address for the coin
+     * @notice If the account address is not found, returns 0
+     */
+    function balanceOf(address account) external view returns (uint256);
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Send `amount` of the coin from msg.sender to `to`
+     * @param to The recipient address
+     * @param amount The amount of the coin to send
+     */
+    function transfer(address to, uint256 amount) external returns (bool);
+}
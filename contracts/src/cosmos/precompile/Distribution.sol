@@ -26,6 +26,25 @@ import {Cosmos} from "../CosmosTypes.sol";
  * @dev Interface of the distribution module's precompiled contract
  */
 interface IDistributionModule {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+    /**
+     * @dev Returned when the validator with the operator address `validator` does not exist
+     * @param validator The validator address
+     */
+    error ValidatorNotFound(address validator);
+
+    /**
+     * @dev Returned when `delegator` has no delegation with `validator`
+     * @param delegator The delegator address
+     * @param validator The validator address
+     */
+    error DelegationNotFound(address delegator, address validator);
+
+    /**
+     * @dev Returned when the withdraw address of delegators cannot be changed
+     */
+    error WithdrawAddressChangeDisabled();
+
     ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
     /**
      * @dev Emitted by the distribution module when `amount` is withdrawn from a delegation with
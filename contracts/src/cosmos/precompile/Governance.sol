@@ -31,6 +31,19 @@ import {Cosmos} from "../CosmosTypes.sol";
  * @dev Interface of the governance module's precompiled contract
  */
 interface IGovernanceModule {
+    ////////////////////////////////////////// Errors /////////////////////////////////////////////
+    /**
+     * @dev Returned when the proposal with the id `proposalId` does not exist.
+     * @param proposalId The id of the proposal.
+     */
+    error ProposalNotFound(uint64 proposalId);
+
+    /**
+     * @dev Returned when the proposal with the id `proposalId` is not in its voting period.
+     * @param proposalId The id of the proposal.
+     */
+    error InactiveProposal(uint64 proposalId);
+
     ////////////////////////////////////////// Write Methods /////////////////////////////////////////////
     /**
      * @dev Submit a proposal to the governance module.
@@ -31,6 +31,28 @@ import {Cosmos} from "../CosmosTypes.sol";
  * @dev Interface of the staking module's precompiled contract
  */
 interface IStakingModule {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Returned when the validator with the operator address `validatorAddress` does not exist
+     * @param validatorAddress The validator operator address
+     */
+    error ValidatorNotFound(address validatorAddress);
+
+    /**
+     * @dev Returned when `delegatorAddress` does not hold enough tokens to delegate `amount`
+     * @param delegatorAddress The delegator address
+     * @param amount The amount of tokens to delegate
+     */
+    error InsufficientFunds(address delegatorAddress, uint256 amount);
+
+    /**
+     * @dev Returned when `delegatorAddress` has no delegation with `validatorAddress`
+     * @param delegatorAddress The delegator address
+     * @param validatorAddress The validator operator address
+     */
+    error DelegationNotFound(address delegatorAddress, address validatorAddress);
+
     ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
 
     /**
@@ -185,14 +207,14 @@ interface IStakingModule {
      * @param validatorAddress The validator operator address
      * @param amount The amount of tokens to delegate
      */
-    function delegate(address validatorAddress, uint256 amount) external payable returns (bool);
+    function delegate(address validatorAddress, uint256 amount) external returns (bool);
 
     /**
      * @dev msg.sender undelegates the `amount` of tokens from `validatorAddress`
      * @param validatorAddress The validator operator address
      * @param amount The amount of tokens to undelegate
      */
-    function undelegate(address validatorAddress, uint256 amount) external payable returns (bool);
+    function undelegate(address validatorAddress, uint256 amount) external returns (bool);
 
     /**
      * @dev msg.sender redelegates the `amount` of tokens from `srcValidator` to `validtorDstAddr`
@@ -202,7 +224,6 @@ interface IStakingModule {
      */
     function beginRedelegate(address srcValidator, address dstValidator, uint256 amount)
         external
-        payable
         returns (bool);
 
     /**
@@ -214,7 +235,6 @@ interface IStakingModule {
      */
     function cancelUnbondingDelegation(address validatorAddress, uint256 amount, int64 creationHeight)
         external
-        payable
         returns (bool);
 
     //////////////////////////////////////////// UTILS ////////////////////////////////////////////
@@ -41,6 +41,10 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	MsgService_EthTransaction_FullMethodName         = "/polaris.evm.v1alpha1.MsgService/EthTransaction"
 	MsgService_ProcessPayloadEnvelope_FullMethodName = "/polaris.evm.v1alpha1.MsgService/ProcessPayloadEnvelope"
+	MsgService_UpdatePrecompileGas_FullMethodName    = "/polaris.evm.v1alpha1.MsgService/UpdatePrecompileGas"
+	MsgService_CreatePrecompile_FullMethodName       = "/polaris.evm.v1alpha1.MsgService/CreatePrecompile"
+	MsgService_SetPrecompilePaused_FullMethodName    = "/polaris.evm.v1alpha1.MsgService/SetPrecompilePaused"
+	MsgService_RemovePrecompile_FullMethodName       = "/polaris.evm.v1alpha1.MsgService/RemovePrecompile"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -51,6 +55,14 @@ type MsgServiceClient interface {
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(ctx context.Context, in *WrappedPayloadEnvelope, opts ...grpc.CallOption) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdatePrecompileGas defines a governance operation to set the base gas of a precompile method.
+	UpdatePrecompileGas(ctx context.Context, in *MsgUpdatePrecompileGas, opts ...grpc.CallOption) (*MsgUpdatePrecompileGasResponse, error)
+	// CreatePrecompile defines a governance operation to instantiate a dynamic precompile.
+	CreatePrecompile(ctx context.Context, in *MsgCreatePrecompile, opts ...grpc.CallOption) (*MsgCreatePrecompileResponse, error)
+	// SetPrecompilePaused defines a governance operation to pause or resume a dynamic precompile.
+	SetPrecompilePaused(ctx context.Context, in *MsgSetPrecompilePaused, opts ...grpc.CallOption) (*MsgSetPrecompilePausedResponse, error)
+	// RemovePrecompile defines a governance operation to remove a dynamic precompile.
+	RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error)
 }
 
 type msgServiceClient struct {
@@ -79,6 +91,42 @@ func (c *msgServiceClient) ProcessPayloadEnvelope(ctx context.Context, in *Wrapp
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdatePrecompileGas(ctx context.Context, in *MsgUpdatePrecompileGas, opts ...grpc.CallOption) (*MsgUpdatePrecompileGasResponse, error) {
+	out := new(MsgUpdatePrecompileGasResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdatePrecompileGas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CreatePrecompile(ctx context.Context, in *MsgCreatePrecompile, opts ...grpc.CallOption) (*MsgCreatePrecompileResponse, error) {
+	out := new(MsgCreatePrecompileResponse)
+	err := c.cc.Invoke(ctx, MsgService_CreatePrecompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetPrecompilePaused(ctx context.Context, in *MsgSetPrecompilePaused, opts ...grpc.CallOption) (*MsgSetPrecompilePausedResponse, error) {
+	out := new(MsgSetPrecompilePausedResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetPrecompilePaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error) {
+	out := new(MsgRemovePrecompileResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemovePrecompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility
@@ -87,6 +135,14 @@ type MsgServiceServer interface {
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdatePrecompileGas defines a governance operation to set the base gas of a precompile method.
+	UpdatePrecompileGas(context.Context, *MsgUpdatePrecompileGas) (*MsgUpdatePrecompileGasResponse, error)
+	// CreatePrecompile defines a governance operation to instantiate a dynamic precompile.
+	CreatePrecompile(context.Context, *MsgCreatePrecompile) (*MsgCreatePrecompileResponse, error)
+	// SetPrecompilePaused defines a governance operation to pause or resume a dynamic precompile.
+	SetPrecompilePaused(context.Context, *MsgSetPrecompilePaused) (*MsgSetPrecompilePausedResponse, error)
+	// RemovePrecompile defines a governance operation to remove a dynamic precompile.
+	RemovePrecompile(context.Context, *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -100,6 +156,18 @@ func (UnimplementedMsgServiceServer) EthTransaction(context.Context, *WrappedEth
 func (UnimplementedMsgServiceServer) ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayloadEnvelope not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdatePrecompileGas(context.Context, *MsgUpdatePrecompileGas) (*MsgUpdatePrecompileGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrecompileGas not implemented")
+}
+func (UnimplementedMsgServiceServer) CreatePrecompile(context.Context, *MsgCreatePrecompile) (*MsgCreatePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrecompile not implemented")
+}
+func (UnimplementedMsgServiceServer) SetPrecompilePaused(context.Context, *MsgSetPrecompilePaused) (*MsgSetPrecompilePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrecompilePaused not implemented")
+}
+func (UnimplementedMsgServiceServer) RemovePrecompile(context.Context, *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePrecompile not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 
 // UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -149,6 +217,78 @@ func _MsgService_ProcessPayloadEnvelope_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdatePrecompileGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrecompileGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdatePrecompileGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdatePrecompileGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdatePrecompileGas(ctx, req.(*MsgUpdatePrecompileGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreatePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreatePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CreatePrecompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreatePrecompile(ctx, req.(*MsgCreatePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetPrecompilePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPrecompilePaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetPrecompilePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetPrecompilePaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetPrecompilePaused(ctx, req.(*MsgSetPrecompilePaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemovePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemovePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemovePrecompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemovePrecompile(ctx, req.(*MsgRemovePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +304,22 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPayloadEnvelope",
 			Handler:    _MsgService_ProcessPayloadEnvelope_Handler,
 		},
+		{
+			MethodName: "UpdatePrecompileGas",
+			Handler:    _MsgService_UpdatePrecompileGas_Handler,
+		},
+		{
+			MethodName: "CreatePrecompile",
+			Handler:    _MsgService_CreatePrecompile_Handler,
+		},
+		{
+			MethodName: "SetPrecompilePaused",
+			Handler:    _MsgService_SetPrecompilePaused_Handler,
+		},
+		{
+			MethodName: "RemovePrecompile",
+			Handler:    _MsgService_RemovePrecompile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
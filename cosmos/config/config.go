@@ -23,6 +23,7 @@ package config
 import (
 	"fmt"
 	"math/big"
+	"path/filepath"
 
 	"github.com/berachain/polaris/cosmos/config/flags"
 	"github.com/berachain/polaris/eth"
@@ -94,6 +95,49 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// Calls
+	if conf.Polar.RPCCallWorkers, err =
+		parser.GetInt(flags.RPCCallWorkers); err != nil {
+		return nil, err
+	}
+	if conf.Polar.RPCCallQueueDepth, err =
+		parser.GetInt(flags.RPCCallQueueDepth); err != nil {
+		return nil, err
+	}
+
+	// Preimages
+	if conf.Polar.EnablePreimageRecording, err =
+		parser.GetBool(flags.EnablePreimageRecording); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.PreimagesDatadir, err =
+		parser.GetString(flags.PreimagesDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.EnablePreimageRecording && conf.Polar.PreimagesDatadir == "" {
+		var home string
+		if home, err = parser.GetString(sdkflags.FlagHome); err != nil {
+			return nil, err
+		}
+		conf.Polar.PreimagesDatadir = filepath.Join(home, "data", "preimages")
+	}
+
+	// Blooms
+	if conf.Polar.BloomsDatadir, err =
+		parser.GetString(flags.BloomsDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BloomsDatadir == "" {
+		var home string
+		if home, err = parser.GetString(sdkflags.FlagHome); err != nil {
+			return nil, err
+		}
+		conf.Polar.BloomsDatadir = filepath.Join(home, "data", "blooms")
+	}
+
 	// Polar Miner settings
 	if conf.Polar.Miner.Etherbase, err =
 		parser.GetCommonAddress(flags.MinerEtherbase); err != nil {
@@ -234,6 +278,16 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// Polar.Precompiles settings
+	if conf.Polar.Precompiles.GasSchedulesBlock, err =
+		parser.GetBigInt(flags.PrecompileGasSchedulesBlock); err != nil {
+		return nil, err
+	}
+	if conf.Polar.Precompiles.NonPayableBlock, err =
+		parser.GetBigInt(flags.PrecompileNonPayableBlock); err != nil {
+		return nil, err
+	}
+
 	// Polar.GPO settings
 	if conf.Polar.GPO.Blocks, err =
 		parser.GetInt(flags.Blocks); err != nil {
@@ -333,6 +387,35 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// BlobPool
+	if conf.Polar.BlobPool.Datadir, err =
+		parser.GetString(flags.BlobDatadir); err != nil {
+		return nil, err
+	}
+
+	// A relative data directory of the blob pool is resolved under the data directory of the
+	// node, so that the blobs survive restarts.
+	if !filepath.IsAbs(conf.Polar.BlobPool.Datadir) {
+		var home string
+		if home, err = parser.GetString(sdkflags.FlagHome); err != nil {
+			return nil, err
+		}
+		if conf.Polar.BlobPool.Datadir == "" {
+			conf.Polar.BlobPool.Datadir = "blobpool"
+		}
+		conf.Polar.BlobPool.Datadir = filepath.Join(home, "data", conf.Polar.BlobPool.Datadir)
+	}
+
+	if conf.Polar.BlobPool.Datacap, err =
+		parser.GetUint64(flags.BlobDatacap); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BlobPool.PriceBump, err =
+		parser.GetUint64(flags.BlobPriceBump); err != nil {
+		return nil, err
+	}
+
 	// Node settings
 	if conf.Node.Name, err =
 		parser.GetString(flags.Name); err != nil {
@@ -21,9 +21,18 @@
 package config_test
 
 import (
+	"bytes"
+	"math/big"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/viper"
+
 	sgconfig "github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/config/flags"
 	"github.com/berachain/polaris/eth/accounts"
 
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -53,3 +62,86 @@ var _ = Describe("Configuration", func() {
 		Expect(hdPath).To(Equal(accounts.BIP44HDPath))
 	})
 })
+
+var _ = Describe("ReadConfigFromAppOpts", func() {
+	const home = "/home/polard"
+	var opts *viper.Viper
+
+	BeforeEach(func() {
+		// Read the default config as it is written to app.toml.
+		var buf bytes.Buffer
+		tmpl := template.Must(template.New("app").Parse(sgconfig.PolarisConfigTemplate))
+		Expect(tmpl.Execute(&buf, struct{ Polaris *sgconfig.Config }{
+			sgconfig.DefaultPolarisConfig(),
+		})).To(Succeed())
+		opts = viper.New()
+		opts.SetConfigType("toml")
+		Expect(opts.ReadConfig(&buf)).To(Succeed())
+		opts.Set(sdkflags.FlagHome, home)
+		opts.Set(flags.MinerExtraData, "0x01")
+	})
+
+	It("should keep the blob pool under the data directory of the node", func() {
+		cfg, err := sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BlobPool.Datadir).To(Equal(filepath.Join(home, "data", "blobpool")))
+
+		opts.Set(flags.BlobDatadir, "blobs")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BlobPool.Datadir).To(Equal(filepath.Join(home, "data", "blobs")))
+
+		opts.Set(flags.BlobDatadir, "/blobs")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BlobPool.Datadir).To(Equal("/blobs"))
+
+		opts.Set(flags.BlobDatadir, "")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BlobPool.Datadir).To(Equal(filepath.Join(home, "data", "blobpool")))
+	})
+
+	It("should keep the preimages under the data directory of the node if recording", func() {
+		cfg, err := sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.PreimagesDatadir).To(BeEmpty())
+
+		opts.Set(flags.EnablePreimageRecording, true)
+		opts.Set(flags.PreimagesDatadir, "")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.PreimagesDatadir).To(Equal(filepath.Join(home, "data", "preimages")))
+
+		opts.Set(flags.PreimagesDatadir, "/preimages")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.PreimagesDatadir).To(Equal("/preimages"))
+	})
+
+	It("should keep the bloom-bits index under the data directory of the node", func() {
+		opts.Set(flags.BloomsDatadir, "")
+		cfg, err := sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BloomsDatadir).To(Equal(filepath.Join(home, "data", "blooms")))
+
+		opts.Set(flags.BloomsDatadir, "/blooms")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.BloomsDatadir).To(Equal("/blooms"))
+	})
+
+	It("should not upgrade the precompiles of a config without the upgrade blocks", func() {
+		cfg, err := sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.Precompiles.GasSchedulesBlock.Cmp(big.NewInt(0))).To(BeZero())
+		Expect(cfg.Polar.Precompiles.NonPayableBlock.Cmp(big.NewInt(0))).To(BeZero())
+
+		opts.Set(flags.PrecompileGasSchedulesBlock, "")
+		opts.Set(flags.PrecompileNonPayableBlock, "")
+		cfg, err = sgconfig.ReadConfigFromAppOpts(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Polar.Precompiles.GasSchedulesBlock).To(BeNil())
+		Expect(cfg.Polar.Precompiles.NonPayableBlock).To(BeNil())
+	})
+})
@@ -28,6 +28,17 @@ const (
 	RPCTxFeeCap   = "polaris.polar.rpc-tx-fee-cap"
 	RPCGasCap     = "polaris.polar.rpc-gas-cap"
 
+	// Calls.
+	RPCCallWorkers    = "polaris.polar.rpc-call-workers"
+	RPCCallQueueDepth = "polaris.polar.rpc-call-queue-depth"
+
+	// Preimages.
+	EnablePreimageRecording = "polaris.polar.enable-preimage-recording"
+	PreimagesDatadir        = "polaris.polar.preimages-datadir"
+
+	// Blooms.
+	BloomsDatadir = "polaris.polar.blooms-datadir"
+
 	// Miner.
 	MinerEtherbase         = "polaris.polar.miner.etherbase"
 	MinerExtraData         = "polaris.polar.miner.extra-data"
@@ -98,6 +109,11 @@ const (
 	GlobalQueue  = "polaris.polar.legacy-tx-pool.global-queue"
 	Lifetime     = "polaris.polar.legacy-tx-pool.lifetime"
 
+	// Blob TxPool.
+	BlobDatadir   = "polaris.polar.blob-pool.datadir"
+	BlobDatacap   = "polaris.polar.blob-pool.datacap"
+	BlobPriceBump = "polaris.polar.blob-pool.price-bump"
+
 	// Chain Config.
 	ChainID                       = "polaris.polar.chain.chain-id"
 	HomesteadBlock                = "polaris.polar.chain.homestead-block"
@@ -122,4 +138,8 @@ const (
 	VerkleTime                    = "polaris.polar.chain.verkle-time"
 	TerminalTotalDifficulty       = "polaris.polar.chain.terminal-total-difficulty"
 	TerminalTotalDifficultyPassed = "polaris.polar.chain.terminal-total-difficulty-passed"
+
+	// Precompile Upgrades.
+	PrecompileGasSchedulesBlock = "polaris.polar.precompiles.gas-schedules-block"
+	PrecompileNonPayableBlock   = "polaris.polar.precompiles.non-payable-block"
 )
@@ -40,6 +40,21 @@ rpc-evm-timeout = "{{ .Polaris.Polar.RPCEVMTimeout }}"
 # Transaction fee cap for RPC requests
 rpc-tx-fee-cap = "{{ .Polaris.Polar.RPCTxFeeCap }}"
 
+# Number of eth_call and eth_estimateGas requests executed concurrently, defaults to the CPU count
+rpc-call-workers = {{ .Polaris.Polar.RPCCallWorkers }}
+
+# Number of eth_call and eth_estimateGas requests waiting for a worker before new ones are rejected
+rpc-call-queue-depth = {{ .Polaris.Polar.RPCCallQueueDepth }}
+
+# Whether to record the SHA3 preimages computed by the EVM, served by debug_preimage
+enable-preimage-recording = {{ .Polaris.Polar.EnablePreimageRecording }}
+
+# Data directory of the recorded preimages, defaults to the data directory of the node
+preimages-datadir = "{{ .Polaris.Polar.PreimagesDatadir }}"
+
+# Data directory of the bloom-bits index of the filters, defaults to the data directory of the node
+blooms-datadir = "{{ .Polaris.Polar.BloomsDatadir }}"
+
 # Chain config
 [polaris.polar.chain] 
 chain-id = "{{ .Polaris.Polar.Chain.ChainID }}"
@@ -110,6 +125,14 @@ terminal-total-difficulty = "{{ .Polaris.Polar.Chain.TerminalTotalDifficulty }}"
 # Whether terminal total difficulty has passed
 terminal-total-difficulty-passed = "{{ .Polaris.Polar.Chain.TerminalTotalDifficultyPassed }}"
 
+# Precompile upgrades, which are part of the chain config
+[polaris.polar.precompiles]
+# Gas schedules upgrade block (nil == no upgrade, 0 = upgraded at genesis)
+gas-schedules-block = "{{ .Polaris.Polar.Precompiles.GasSchedulesBlock }}"
+
+# Non-payable methods upgrade block (nil == no upgrade, 0 = upgraded at genesis)
+non-payable-block = "{{ .Polaris.Polar.Precompiles.NonPayableBlock }}"
+
 
 # Miner config
 [polaris.polar.miner]
@@ -194,6 +217,18 @@ global-queue = "{{ .Polaris.Polar.LegacyTxPool.GlobalQueue }}"
 # Maximum amount of time non-executable transaction are queued
 lifetime = "{{ .Polaris.Polar.LegacyTxPool.Lifetime }}"
 
+# BlobPool settings
+[polaris.polar.blob-pool]
+
+# Data directory containing the currently executable blobs, relative to the data directory of the node
+datadir = "{{ .Polaris.Polar.BlobPool.Datadir }}"
+
+# Soft-cap of database storage (hard cap is larger due to overhead)
+datacap = "{{ .Polaris.Polar.BlobPool.Datacap }}"
+
+# Minimum price bump percentage to replace an already existing nonce
+price-bump = "{{ .Polaris.Polar.BlobPool.PriceBump }}"
+
 
 # Node-specific settings
 [polaris.node]
@@ -22,74 +22,79 @@ package lib
 
 import (
 	"math/big"
-	"reflect"
 
 	"cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
 
-	libgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	"github.com/berachain/polaris/contracts/bindings/cosmos/precompile/governance"
 	"github.com/berachain/polaris/contracts/bindings/cosmos/precompile/staking"
 	"github.com/berachain/polaris/cosmos/precompile"
-	"github.com/berachain/polaris/lib/utils"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-
-	"github.com/ethereum/go-ethereum/common"
 )
 
 /**
  * This file contains conversions between native Cosmos SDK types and go-ethereum ABI types.
  */
 
-// SdkCoinsToEvmCoins converts sdk.Coins into []libgenerated.CosmosCoin.
-func SdkCoinsToEvmCoins(sdkCoins sdk.Coins) []libgenerated.CosmosCoin {
-	evmCoins := make([]libgenerated.CosmosCoin, len(sdkCoins))
+// The Go structs of the Cosmos ABI types. abigen generates a named copy of these structs in the
+// bindings package of every precompile, so the conversions below are generic over the copies.
+type (
+	evmCoin = struct {
+		Amount *big.Int
+		Denom  string
+	}
+	evmPageRequest = struct {
+		Key        string
+		Offset     uint64
+		Limit      uint64
+		CountTotal bool
+		Reverse    bool
+	}
+	evmPageResponse = struct {
+		NextKey string
+		Total   uint64
+	}
+)
+
+// SdkCoinsToEvmCoins converts sdk.Coins into the generated `Cosmos.Coin` structs.
+func SdkCoinsToEvmCoins[C ~evmCoin](sdkCoins sdk.Coins) []C {
+	evmCoins := make([]C, len(sdkCoins))
 	for i, coin := range sdkCoins {
-		evmCoins[i] = SdkCoinToEvmCoin(coin)
+		evmCoins[i] = SdkCoinToEvmCoin[C](coin)
 	}
 	return evmCoins
 }
 
-// SdkCoinToEvmCoin converts sdk.Coin into libgenerated.CosmosCoin.
-func SdkCoinToEvmCoin(coin sdk.Coin) libgenerated.CosmosCoin {
-	evmCoin := libgenerated.CosmosCoin{
+// SdkCoinToEvmCoin converts sdk.Coin into the generated `Cosmos.Coin` struct.
+func SdkCoinToEvmCoin[C ~evmCoin](coin sdk.Coin) C {
+	return C(evmCoin{
 		Amount: coin.Amount.BigInt(),
 		Denom:  coin.Denom,
-	}
-	return evmCoin
+	})
 }
 
-func SdkPageResponseToEvmPageResponse(
-	pageResponse *query.PageResponse,
-) libgenerated.CosmosPageResponse {
+// SdkPageResponseToEvmPageResponse converts a query.PageResponse into the generated
+// `Cosmos.PageResponse` struct.
+func SdkPageResponseToEvmPageResponse[R ~evmPageResponse](pageResponse *query.PageResponse) R {
 	if pageResponse == nil {
-		return libgenerated.CosmosPageResponse{}
+		return R{}
 	}
-	return libgenerated.CosmosPageResponse{
+	return R(evmPageResponse{
 		NextKey: string(pageResponse.GetNextKey()),
 		Total:   pageResponse.GetTotal(),
-	}
+	})
 }
 
-// ExtractCoinsFromInput converts coins from input (of type any) into sdk.Coins.
-func ExtractCoinsFromInput(coins any) (sdk.Coins, error) {
-	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
-	// the any type input into IBankModuleCoin.
-	amounts, ok := utils.GetAs[[]struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}](coins)
-	if !ok {
-		return nil, precompile.ErrInvalidCoin
-	}
-
+// ExtractCoinsFromInput converts the generated `Cosmos.Coin` structs into sdk.Coins.
+func ExtractCoinsFromInput[C ~evmCoin](coins []C) (sdk.Coins, error) {
 	sdkCoins := sdk.Coins{}
-	for _, evmCoin := range amounts {
+	for _, coin := range coins {
+		evmCoin := evmCoin(coin)
 		sdkCoin := sdk.Coin{
 			Denom: evmCoin.Denom, Amount: sdkmath.NewIntFromBigInt(evmCoin.Amount),
 		}
@@ -106,20 +111,10 @@ func ExtractCoinsFromInput(coins any) (sdk.Coins, error) {
 	return sdkCoins.Sort(), nil
 }
 
-func ExtractPageRequestFromInput(pageRequest any) *query.PageRequest {
-	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
-	// the any type input into the contract's generated type.
-	pageReq, ok := utils.GetAs[struct {
-		Key        string `json:"key"`
-		Offset     uint64 `json:"offset"`
-		Limit      uint64 `json:"limit"`
-		CountTotal bool   `json:"count_total"`
-		Reverse    bool   `json:"reverse"`
-	}](pageRequest)
-	if !ok {
-		return nil
-	}
-
+// ExtractPageRequestFromInput converts the generated `Cosmos.PageRequest` struct into a
+// query.PageRequest.
+func ExtractPageRequestFromInput[R ~evmPageRequest](pageRequest R) *query.PageRequest {
+	pageReq := evmPageRequest(pageRequest)
 	return &query.PageRequest{
 		Key:        []byte(pageReq.Key),
 		Offset:     pageReq.Offset,
@@ -129,21 +124,12 @@ func ExtractPageRequestFromInput(pageRequest any) *query.PageRequest {
 	}
 }
 
-// ExtractCoinFromInputToCoin converts a coin from input (of type any) into sdk.Coins.
-func ExtractCoinFromInputToCoin(coin any) (sdk.Coin, error) {
-	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
-	// the any type input into IBankModuleCoin.
-	amounts, ok := utils.GetAs[struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}](coin)
-	if !ok {
-		return sdk.Coin{}, precompile.ErrInvalidCoin
-	}
-
+// ExtractCoinFromInputToCoin converts the generated `Cosmos.Coin` struct into sdk.Coin.
+func ExtractCoinFromInputToCoin[C ~evmCoin](coin C) (sdk.Coin, error) {
+	evmCoin := evmCoin(coin)
 	sdkCoin := sdk.Coin{
-		Denom:  amounts.Denom,
-		Amount: sdkmath.NewIntFromBigInt(amounts.Amount),
+		Denom:  evmCoin.Denom,
+		Amount: sdkmath.NewIntFromBigInt(evmCoin.Amount),
 	}
 	if err := sdkCoin.Validate(); err != nil {
 		return sdk.Coin{}, err
@@ -292,35 +278,25 @@ func SdkProposalToGovProposal(
 // ConvertMsgSubmitProposalToSdk is a helper function to convert a `MsgSubmitProposal` to the gov
 // `v1.MsgSubmitProposal`.
 func ConvertMsgSubmitProposalToSdk(
-	prop any, ir codectypes.InterfaceRegistry, addressCodec address.Codec,
+	prop governance.IGovernanceModuleMsgSubmitProposal,
+	ir codectypes.InterfaceRegistry, addressCodec address.Codec,
 ) (*v1.MsgSubmitProposal, error) {
-	// Convert the prop object to the desired unnamed struct using reflection.
-	propValue := reflect.ValueOf(prop)
-
 	// Build the proposal messages.
-	proposalMsgs, err := decodeProposalMessages(propValue.FieldByName("Messages").Interface())
-	if err != nil {
-		return nil, err
-	}
-	messages := make([]*codectypes.Any, len(proposalMsgs))
-	for i, genCodecAny := range proposalMsgs {
+	messages := make([]*codectypes.Any, len(prop.Messages))
+	for i, genCodecAny := range prop.Messages {
 		messages[i] = &codectypes.Any{
 			Value:   genCodecAny.Value,
 			TypeUrl: genCodecAny.TypeURL,
 		}
 		var msg sdk.Msg
-		if err = ir.UnpackAny(messages[i], &msg); err != nil {
+		if err := ir.UnpackAny(messages[i], &msg); err != nil {
 			return nil, err
 		}
 	}
 
 	// Build the initial deposit.
-	initDeposit, err := decodeInitialDeposit(propValue.FieldByName("InitialDeposit").Interface())
-	if err != nil {
-		return nil, err
-	}
-	initialDeposit := make(sdk.Coins, len(initDeposit))
-	for i, coin := range initDeposit {
+	initialDeposit := make(sdk.Coins, len(prop.InitialDeposit))
+	for i, coin := range prop.InitialDeposit {
 		initialDeposit[i] = sdk.Coin{
 			Denom:  coin.Denom,
 			Amount: sdkmath.NewIntFromBigInt(coin.Amount),
@@ -328,9 +304,7 @@ func ConvertMsgSubmitProposalToSdk(
 	}
 
 	// Return the v1.MsgSubmitProposal with all string fields attached.
-	proposer, err := StringFromEthAddress(
-		addressCodec, propValue.FieldByName("Proposer").Interface().(common.Address),
-	)
+	proposer, err := StringFromEthAddress(addressCodec, prop.Proposer)
 	if err != nil {
 		return nil, err
 	}
@@ -338,83 +312,9 @@ func ConvertMsgSubmitProposalToSdk(
 		Messages:       messages,
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
-		Metadata:       propValue.FieldByName("Metadata").Interface().(string),
-		Title:          propValue.FieldByName("Title").Interface().(string),
-		Summary:        propValue.FieldByName("Summary").Interface().(string),
-		Expedited:      propValue.FieldByName("Expedited").Interface().(bool),
+		Metadata:       prop.Metadata,
+		Title:          prop.Title,
+		Summary:        prop.Summary,
+		Expedited:      prop.Expedited,
 	}, nil
 }
-
-// decodeProposalMessages is a helper function to convert the unnamed type of Messages into a
-// usable Messages slice.
-func decodeProposalMessages(propMsgs any) ([]struct {
-	TypeURL string `json:"typeURL"`
-	Value   []byte `json:"value"`
-}, error) {
-	var proposalMsgs []struct {
-		TypeURL string `json:"typeURL"`
-		Value   []byte `json:"value"`
-	}
-
-	switch reflect.TypeOf(propMsgs) {
-	case reflect.TypeOf([]governance.CosmosCodecAny{}):
-		for _, propMsg := range utils.MustGetAs[[]governance.CosmosCodecAny](propMsgs) {
-			proposalMsgs = append(proposalMsgs, struct {
-				TypeURL string `json:"typeURL"`
-				Value   []byte `json:"value"`
-			}{
-				TypeURL: propMsg.TypeURL,
-				Value:   propMsg.Value,
-			})
-		}
-	case reflect.TypeOf([]struct {
-		TypeURL string `json:"typeURL"`
-		Value   []byte `json:"value"`
-	}{}):
-		proposalMsgs = utils.MustGetAs[[]struct {
-			TypeURL string `json:"typeURL"`
-			Value   []byte `json:"value"`
-		}](propMsgs)
-	default:
-		return nil, precompile.ErrInvalidSubmitProposal
-	}
-
-	return proposalMsgs, nil
-}
-
-// decodeInitialDeposit is a helper function to convert the unnamed type of InitialDeposit into a
-// usable coins slice.
-func decodeInitialDeposit(initDep any) ([]struct {
-	Amount *big.Int `json:"amount"`
-	Denom  string   `json:"denom"`
-}, error) {
-	var initDeposit []struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}
-
-	switch reflect.TypeOf(initDep) {
-	case reflect.TypeOf([]governance.CosmosCoin{}):
-		for _, coin := range utils.MustGetAs[[]governance.CosmosCoin](initDep) {
-			initDeposit = append(initDeposit, struct {
-				Amount *big.Int `json:"amount"`
-				Denom  string   `json:"denom"`
-			}{
-				Amount: coin.Amount,
-				Denom:  coin.Denom,
-			})
-		}
-	case reflect.TypeOf([]struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}{}):
-		initDeposit = utils.MustGetAs[[]struct {
-			Amount *big.Int `json:"amount"`
-			Denom  string   `json:"denom"`
-		}](initDep)
-	default:
-		return nil, precompile.ErrInvalidSubmitProposal
-	}
-
-	return initDeposit, nil
-}
@@ -22,22 +22,31 @@ package bank
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"cosmossdk.io/core/address"
 
-	"github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	bankgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/bank"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
+	"github.com/berachain/polaris/cosmos/precompile"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/core/vm"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// errInsufficientFunds is the name of the custom error of the bank precompiles, as declared in
+// `IBankModule` and `IBankDenom`, which is returned when the sender cannot cover a transfer.
+const errInsufficientFunds = "InsufficientFunds"
+
+// Contract implements the Go interface generated from the ABI of the bank precompile.
+var _ bankgenerated.BankModulePrecompile = (*Contract)(nil)
+
 // Contract is the precompile contract for the bank module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -62,6 +71,25 @@ func NewPrecompileContract(
 	}
 }
 
+// Dispatchers implements ethprecompile.DispatcherImpl, so the methods of the contract are run by
+// the generated dispatchers instead of by reflection.
+func (c *Contract) Dispatchers() map[string]ethprecompile.Dispatcher {
+	return bankgenerated.BankModuleDispatchers(c)
+}
+
+// GasSchedule implements the `ethprecompile.GasScheduleImpl` interface.
+func (c *Contract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"getBalance":              {Base: precompile.QueryBaseGas},
+		"getAllBalances":          {Base: precompile.PaginatedQueryBaseGas},
+		"getSpendableBalance":     {Base: precompile.QueryBaseGas},
+		"getAllSpendableBalances": {Base: precompile.PaginatedQueryBaseGas},
+		"getSupply":               {Base: precompile.QueryBaseGas},
+		"getAllSupply":            {Base: precompile.PaginatedQueryBaseGas},
+		"send":                    {Base: precompile.TxBaseGas},
+	}
+}
+
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 	return ethprecompile.ValueDecoders{
 		banktypes.AttributeKeySender:    c.ConvertAccAddressFromString,
@@ -100,7 +128,7 @@ func (c *Contract) GetBalance(
 func (c *Contract) GetAllBalances(
 	ctx context.Context,
 	accountAddress common.Address,
-) ([]lib.CosmosCoin, error) {
+) ([]bankgenerated.CosmosCoin, error) {
 	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, accountAddress)
 	if err != nil {
 		return nil, err
@@ -113,7 +141,7 @@ func (c *Contract) GetAllBalances(
 		return nil, err
 	}
 
-	return cosmlib.SdkCoinsToEvmCoins(res.Balances), nil
+	return cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](res.Balances), nil
 }
 
 // GetSpendableBalance implements `getSpendableBalanceByDenom(address,string)` method.
@@ -143,7 +171,7 @@ func (c *Contract) GetSpendableBalance(
 func (c *Contract) GetAllSpendableBalances(
 	ctx context.Context,
 	accountAddress common.Address,
-) ([]lib.CosmosCoin, error) {
+) ([]bankgenerated.CosmosCoin, error) {
 	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, accountAddress)
 	if err != nil {
 		return nil, err
@@ -156,7 +184,7 @@ func (c *Contract) GetAllSpendableBalances(
 		return nil, err
 	}
 
-	return cosmlib.SdkCoinsToEvmCoins(res.Balances), nil
+	return cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](res.Balances), nil
 }
 
 // GetSupply implements `getSupply(string)` method.
@@ -178,21 +206,21 @@ func (c *Contract) GetSupply(
 // GetAllSupply implements `getAllSupply()` method.
 func (c *Contract) GetAllSupply(
 	ctx context.Context,
-) ([]lib.CosmosCoin, error) {
+) ([]bankgenerated.CosmosCoin, error) {
 	// todo: add pagination here
 	res, err := c.querier.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{})
 	if err != nil {
 		return nil, err
 	}
 
-	return cosmlib.SdkCoinsToEvmCoins(res.Supply), nil
+	return cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](res.Supply), nil
 }
 
 // Send implements `send(address,(uint256,string)[])` method.
 func (c *Contract) Send(
 	ctx context.Context,
 	toAddress common.Address,
-	coins any,
+	coins []bankgenerated.CosmosCoin,
 ) (bool, error) {
 	amount, err := cosmlib.ExtractCoinsFromInput(coins)
 	if err != nil {
@@ -214,6 +242,11 @@ func (c *Contract) Send(
 		ToAddress:   toAddr,
 		Amount:      amount,
 	})
+	if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+		return false, ethprecompile.NewCustomError(
+			errInsufficientFunds, vm.UnwrapPolarContext(ctx).MsgSender(), coins,
+		)
+	}
 	return err == nil, err
 }
 
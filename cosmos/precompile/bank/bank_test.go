@@ -22,6 +22,7 @@ package bank_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -29,6 +30,8 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
+	bankgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/bank"
+	cosmlib "github.com/berachain/polaris/cosmos/lib"
 	"github.com/berachain/polaris/cosmos/precompile"
 	"github.com/berachain/polaris/cosmos/precompile/bank"
 	testutils "github.com/berachain/polaris/cosmos/testutil"
@@ -45,6 +48,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethvm "github.com/ethereum/go-ethereum/core/vm"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -426,7 +430,7 @@ var _ = Describe("Bank Precompile Test", func() {
 				_, err = contract.Send(
 					pCtx,
 					common.BytesToAddress(toAcc),
-					sdkCoinsToEvmCoins(sortedSdkCoins),
+					cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](sortedSdkCoins),
 				)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -436,6 +440,59 @@ var _ = Describe("Bank Precompile Test", func() {
 				Expect(balances.Balances).To(Equal(sortedSdkCoins))
 			})
 
+			It("should send with the generated dispatcher", func() {
+				balanceAmount := big.NewInt(1000)
+				accs := simtestutil.CreateRandomAccounts(2)
+				fromAcc, toAcc := accs[0], accs[1]
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(balanceAmount)))
+				Expect(FundAccount(sdk.UnwrapSDKContext(ctx), bk, fromAcc, coins)).To(Succeed())
+				bk.SetSendEnabled(ctx, denom, true)
+
+				pc, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+				Expect(err).ToNot(HaveOccurred())
+				method := contract.ABIMethods()["send"]
+				args, err := method.Inputs.Pack(
+					common.BytesToAddress(toAcc),
+					cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](coins),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = pc.Run(
+					ctx, nil, append(method.ID, args...), common.BytesToAddress(fromAcc), new(big.Int),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(bk.GetBalance(ctx, toAcc, denom).Amount.BigInt()).To(Equal(balanceAmount))
+			})
+
+			It("should revert with InsufficientFunds if the sender lacks the coins", func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				fromAcc, toAcc := accs[0], accs[1]
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000)))
+				bk.SetSendEnabled(ctx, denom, true)
+
+				pc, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+				Expect(err).ToNot(HaveOccurred())
+				method := contract.ABIMethods()["send"]
+				args, err := method.Inputs.Pack(
+					common.BytesToAddress(toAcc),
+					cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](coins),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ret, err := pc.Run(
+					ctx, nil, append(method.ID, args...),
+					common.BytesToAddress(fromAcc), new(big.Int),
+				)
+				Expect(errors.Is(err, ethvm.ErrExecutionReverted)).To(BeTrue())
+
+				abiErr := contract.ABIErrors()["InsufficientFunds"]
+				Expect(ret[:ethprecompile.NumBytesMethodID]).To(
+					Equal(abiErr.ID[:ethprecompile.NumBytesMethodID]),
+				)
+				unpacked, err := abiErr.Unpack(ret)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unpacked.([]any)[0]).To(Equal(common.BytesToAddress(fromAcc)))
+			})
+
 			It("should error when sending 0 coins", func() {
 				balanceAmount, ok := new(big.Int).SetString("22000000000000000000", 10)
 				Expect(ok).To(BeTrue())
@@ -458,7 +515,7 @@ var _ = Describe("Bank Precompile Test", func() {
 				_, err = contract.Send(
 					ctx,
 					common.BytesToAddress(toAcc),
-					sdkCoinsToEvmCoins(coinsToSend),
+					cosmlib.SdkCoinsToEvmCoins[bankgenerated.CosmosCoin](coinsToSend),
 				)
 				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
 			})
@@ -466,6 +523,83 @@ var _ = Describe("Bank Precompile Test", func() {
 	})
 })
 
+var _ = Describe("Bank Denom Precompile Test", func() {
+	var (
+		contract ethprecompile.DynamicImpl
+		pc       ethvm.PrecompiledContract
+		bk       bankkeeper.BaseKeeper
+		ctx      context.Context
+		denom    = "abera"
+		pcAddr   = common.BytesToAddress([]byte("abera"))
+	)
+
+	BeforeEach(func() {
+		var ak authkeeper.AccountKeeperI
+		ctx, ak, bk, _ = testutils.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+
+		var err error
+		contract, err = bank.NewDenomConstructor(ak, bankkeeper.NewMsgServerImpl(bk), bk)(
+			pcAddr, denom,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		pc, err = ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should run the methods with the generated dispatchers", func() {
+		abiMethod := contract.ABIMethods()["denom"]
+		ret, err := pc.Run(ctx, nil, abiMethod.ID, common.Address{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		unpacked, err := abiMethod.Outputs.Unpack(ret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unpacked).To(Equal([]any{denom}))
+	})
+
+	It("should charge the base gas of the methods", func() {
+		Expect(pc.RequiredGas(contract.ABIMethods()["denom"].ID)).
+			To(Equal(precompile.QueryBaseGas))
+		Expect(pc.RequiredGas(contract.ABIMethods()["transfer"].ID)).
+			To(Equal(precompile.TxBaseGas))
+	})
+
+	It("should be instantiated for a valid denom", func() {
+		Expect(contract.RegistryKey()).To(Equal(pcAddr))
+		Expect(contract.Name()).To(Equal(bank.DenomKind + "/" + denom))
+
+		_, err := bank.NewDenomConstructor(nil, nil, nil)(pcAddr, "_invalid_denom")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should transfer the denom", func() {
+		dc := utils.MustGetAs[*bank.DenomContract](contract)
+		balanceAmount := big.NewInt(1000)
+		accs := simtestutil.CreateRandomAccounts(2)
+		fromAcc, toAcc := accs[0], accs[1]
+		Expect(FundAccount(
+			sdk.UnwrapSDKContext(ctx), bk, fromAcc,
+			sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(balanceAmount))),
+		)).To(Succeed())
+		bk.SetSendEnabled(ctx, denom, true)
+
+		pCtx := vm.NewPolarContext(ctx, nil, common.BytesToAddress(fromAcc), new(big.Int))
+		_, err := dc.Transfer(pCtx, common.BytesToAddress(toAcc), big.NewInt(0))
+		Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+		ok, err := dc.Transfer(pCtx, common.BytesToAddress(toAcc), big.NewInt(400))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+
+		balance, err := dc.BalanceOf(pCtx, common.BytesToAddress(toAcc))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(400)))
+		supply, err := dc.TotalSupply(pCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supply).To(Equal(balanceAmount))
+		res, err := dc.Denom(pCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(denom))
+	})
+})
+
 func FundAccount(
 	ctx sdk.Context,
 	bk bankkeeper.BaseKeeper,
@@ -477,22 +611,3 @@ func FundAccount(
 	}
 	return bk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, account, coins)
 }
-func sdkCoinsToEvmCoins(sdkCoins sdk.Coins) []struct {
-	Amount *big.Int `json:"amount"`
-	Denom  string   `json:"denom"`
-} {
-	evmCoins := make([]struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}, len(sdkCoins))
-	for i, coin := range sdkCoins {
-		evmCoins[i] = struct {
-			Amount *big.Int `json:"amount"`
-			Denom  string   `json:"denom"`
-		}{
-			Amount: coin.Amount.BigInt(),
-			Denom:  coin.Denom,
-		}
-	}
-	return evmCoins
-}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+
+	denomgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/bankdenom"
+	cosmlib "github.com/berachain/polaris/cosmos/lib"
+	"github.com/berachain/polaris/cosmos/precompile"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DenomKind is the kind of the dynamic precompiles of the bank module, which are instantiated by
+// governance for a single bank denom each.
+const DenomKind = "bank/denom"
+
+// DenomContract implements the Go interface generated from the ABI of the bank denom precompile.
+var _ denomgenerated.BankDenomPrecompile = (*DenomContract)(nil)
+
+// DenomContract is the dynamic precompile contract of a single bank denom.
+type DenomContract struct {
+	ethprecompile.BaseContract
+
+	denom        string
+	addressCodec address.Codec
+	msgServer    banktypes.MsgServer
+	querier      banktypes.QueryServer
+}
+
+// NewDenomConstructor returns the constructor of the bank denom precompile contracts, which are
+// instantiated with the denom as the arguments.
+func NewDenomConstructor(
+	ak cosmlib.CodecProvider, ms banktypes.MsgServer, qs banktypes.QueryServer,
+) ethprecompile.DynamicConstructor {
+	return func(addr common.Address, denom string) (ethprecompile.DynamicImpl, error) {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, err
+		}
+		return &DenomContract{
+			BaseContract: ethprecompile.NewBaseContract(
+				denomgenerated.BankDenomMetaData.ABI, addr,
+			),
+			denom:        denom,
+			addressCodec: ak.AddressCodec(),
+			msgServer:    ms,
+			querier:      qs,
+		}, nil
+	}
+}
+
+// Name implements ethprecompile.DynamicImpl.
+func (c *DenomContract) Name() string {
+	return DenomKind + "/" + c.denom
+}
+
+// Dispatchers implements ethprecompile.DispatcherImpl, so the methods of the contract are run by
+// the generated dispatchers instead of by reflection.
+func (c *DenomContract) Dispatchers() map[string]ethprecompile.Dispatcher {
+	return denomgenerated.BankDenomDispatchers(c)
+}
+
+// GasSchedule implements the `ethprecompile.GasScheduleImpl` interface.
+func (c *DenomContract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"denom":       {Base: precompile.QueryBaseGas},
+		"totalSupply": {Base: precompile.QueryBaseGas},
+		"balanceOf":   {Base: precompile.QueryBaseGas},
+		"transfer":    {Base: precompile.TxBaseGas},
+	}
+}
+
+func (c *DenomContract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		banktypes.AttributeKeySender:    c.ConvertAccAddressFromString,
+		banktypes.AttributeKeyRecipient: c.ConvertAccAddressFromString,
+		banktypes.AttributeKeySpender:   c.ConvertAccAddressFromString,
+		banktypes.AttributeKeyReceiver:  c.ConvertAccAddressFromString,
+	}
+}
+
+// Denom implements `denom()` method.
+func (c *DenomContract) Denom(context.Context) (string, error) {
+	return c.denom, nil
+}
+
+// TotalSupply implements `totalSupply()` method.
+func (c *DenomContract) TotalSupply(ctx context.Context) (*big.Int, error) {
+	res, err := c.querier.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: c.denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetAmount().Amount.BigInt(), nil
+}
+
+// BalanceOf implements `balanceOf(address)` method.
+func (c *DenomContract) BalanceOf(ctx context.Context, account common.Address) (*big.Int, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, account)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.querier.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: accAddr,
+		Denom:   c.denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetBalance().Amount.BigInt(), nil
+}
+
+// Transfer implements `transfer(address,uint256)` method.
+func (c *DenomContract) Transfer(
+	ctx context.Context,
+	to common.Address,
+	amount *big.Int,
+) (bool, error) {
+	if amount.Sign() <= 0 {
+		return false, precompile.ErrInvalidCoin
+	}
+	caller, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	toAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, to)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.Send(ctx, &banktypes.MsgSend{
+		FromAddress: caller,
+		ToAddress:   toAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin(c.denom, sdkmath.NewIntFromBigInt(amount))),
+	})
+	if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+		return false, ethprecompile.NewCustomError(
+			errInsufficientFunds, vm.UnwrapPolarContext(ctx).MsgSender(), amount,
+		)
+	}
+	return err == nil, err
+}
+
+// ConvertAccAddressFromString converts a Cosmos string representing a account address to a
+// common.Address.
+func (c *DenomContract) ConvertAccAddressFromString(attributeValue string) (any, error) {
+	return cosmlib.EthAddressFromString(c.addressCodec, attributeValue)
+}
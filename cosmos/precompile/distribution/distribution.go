@@ -22,12 +22,13 @@ package distribution
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/core/address"
 
-	"github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	generated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/distribution"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
+	"github.com/berachain/polaris/cosmos/precompile"
 	"github.com/berachain/polaris/cosmos/precompile/staking"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	pvm "github.com/berachain/polaris/eth/core/vm"
@@ -38,6 +39,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Names of the custom errors of the distribution precompile, as declared in `IDistributionModule`.
+const (
+	errValidatorNotFound             = "ValidatorNotFound"
+	errDelegationNotFound            = "DelegationNotFound"
+	errWithdrawAddressChangeDisabled = "WithdrawAddressChangeDisabled"
+)
+
+// Contract implements the Go interface generated from the ABI of the distribution precompile.
+var _ generated.DistributionModulePrecompile = (*Contract)(nil)
+
 // Contract is the precompile contract for the distribution module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -67,6 +78,25 @@ func NewPrecompileContract(
 	}
 }
 
+// Dispatchers implements ethprecompile.DispatcherImpl, so the methods of the contract are run by
+// the generated dispatchers instead of by reflection.
+func (c *Contract) Dispatchers() map[string]ethprecompile.Dispatcher {
+	return generated.DistributionModuleDispatchers(c)
+}
+
+// GasSchedule implements the `ethprecompile.GasScheduleImpl` interface.
+func (c *Contract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"setWithdrawAddress":          {Base: precompile.TxBaseGas},
+		"getWithdrawAddress":          {Base: precompile.QueryBaseGas},
+		"getWithdrawEnabled":          {Base: precompile.QueryBaseGas},
+		"withdrawDelegatorReward":     {Base: precompile.TxBaseGas},
+		"getDelegatorValidatorReward": {Base: precompile.QueryBaseGas},
+		"getAllDelegatorRewards":      {Base: precompile.PaginatedQueryBaseGas},
+		"getTotalDelegatorReward":     {Base: precompile.QueryBaseGas},
+	}
+}
+
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 	return ethprecompile.ValueDecoders{
 		distributiontypes.AttributeKeyValidator:       c.ConvertValAddressFromString,
@@ -95,6 +125,9 @@ func (c *Contract) SetWithdrawAddress(
 		DelegatorAddress: delAddr,
 		WithdrawAddress:  withdrawAddr,
 	})
+	if errors.Is(err, distributiontypes.ErrSetWithdrawAddrDisabled) {
+		return false, ethprecompile.NewCustomError(errWithdrawAddressChangeDisabled)
+	}
 	return err == nil, err
 }
 
@@ -140,7 +173,7 @@ func (c *Contract) WithdrawDelegatorReward(
 	ctx context.Context,
 	delegator common.Address,
 	validator common.Address,
-) ([]lib.CosmosCoin, error) {
+) ([]generated.CosmosCoin, error) {
 	delAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, delegator)
 	if err != nil {
 		return nil, err
@@ -156,12 +189,12 @@ func (c *Contract) WithdrawDelegatorReward(
 			ValidatorAddress: valAddr,
 		})
 	if err != nil {
-		return nil, err
+		return nil, rewardsError(err, delegator, validator)
 	}
 
-	amount := make([]lib.CosmosCoin, 0)
+	amount := make([]generated.CosmosCoin, 0)
 	for _, coin := range res.Amount {
-		amount = append(amount, lib.CosmosCoin{
+		amount = append(amount, generated.CosmosCoin{
 			Denom:  coin.Denom,
 			Amount: coin.Amount.BigInt(),
 		})
@@ -175,7 +208,7 @@ func (c *Contract) GetDelegatorValidatorReward(
 	ctx context.Context,
 	delegator common.Address,
 	validator common.Address,
-) ([]lib.CosmosCoin, error) {
+) ([]generated.CosmosCoin, error) {
 	delAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, delegator)
 	if err != nil {
 		return nil, err
@@ -197,12 +230,12 @@ func (c *Contract) GetDelegatorValidatorReward(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, rewardsError(err, delegator, validator)
 	}
 
-	amount := make([]lib.CosmosCoin, 0)
+	amount := make([]generated.CosmosCoin, 0)
 	for _, coin := range res.Rewards {
-		amount = append(amount, lib.CosmosCoin{
+		amount = append(amount, generated.CosmosCoin{
 			Denom:  coin.Denom,
 			Amount: coin.Amount.TruncateInt().BigInt(),
 		})
@@ -265,7 +298,7 @@ func (c *Contract) GetAllDelegatorRewards(
 func (c *Contract) GetTotalDelegatorReward(
 	ctx context.Context,
 	delegator common.Address,
-) ([]lib.CosmosCoin, error) {
+) ([]generated.CosmosCoin, error) {
 	delAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, delegator)
 	if err != nil {
 		return nil, err
@@ -285,9 +318,9 @@ func (c *Contract) GetTotalDelegatorReward(
 		return nil, err
 	}
 
-	amount := make([]lib.CosmosCoin, 0, len(res.Total))
+	amount := make([]generated.CosmosCoin, 0, len(res.Total))
 	for _, coin := range res.Total {
-		amount = append(amount, lib.CosmosCoin{
+		amount = append(amount, generated.CosmosCoin{
 			Denom:  coin.Denom,
 			Amount: coin.Amount.TruncateInt().BigInt(),
 		})
@@ -295,6 +328,21 @@ func (c *Contract) GetTotalDelegatorReward(
 	return amount, nil
 }
 
+// rewardsError returns the custom error of `IDistributionModule` that corresponds to the given
+// error of the rewards of the delegation of the delegator to the validator. Errors without a
+// custom error are returned as is.
+func rewardsError(err error, delegator, validator common.Address) error {
+	switch {
+	case errors.Is(err, distributiontypes.ErrNoValidatorDistInfo),
+		errors.Is(err, distributiontypes.ErrNoValidatorExists):
+		return ethprecompile.NewCustomError(errValidatorNotFound, validator)
+	case errors.Is(err, distributiontypes.ErrEmptyDelegationDistInfo),
+		errors.Is(err, distributiontypes.ErrNoDelegationExists):
+		return ethprecompile.NewCustomError(errDelegationNotFound, delegator, validator)
+	}
+	return err
+}
+
 // ConvertValAddressFromBech32 converts a Cosmos string representing a validator address to a
 // common.Address.
 func (c *Contract) ConvertValAddressFromString(attributeValue string) (any, error) {
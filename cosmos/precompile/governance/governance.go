@@ -23,13 +23,18 @@ package governance
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 
-	cbindings "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	generated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/governance"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
+	"github.com/berachain/polaris/cosmos/precompile"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/precompile/log"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/core/vm"
@@ -43,6 +48,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Names of the custom errors of the governance precompile, as declared in `IGovernanceModule`.
+const (
+	errProposalNotFound = "ProposalNotFound"
+	errInactiveProposal = "InactiveProposal"
+)
+
 const (
 	EventTypeProposalSubmitted = `proposal_submitted`
 	EventTypeProposalVoted     = `proposal_voted`
@@ -50,6 +61,9 @@ const (
 	AttributeProposalVote      = `proposal_vote`
 )
 
+// Contract implements the Go interface generated from the ABI of the governance precompile.
+var _ generated.GovernanceModulePrecompile = (*Contract)(nil)
+
 // Contract is the precompile contract for the governance module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -77,6 +91,34 @@ func NewPrecompileContract(
 	}
 }
 
+// Dispatchers implements ethprecompile.DispatcherImpl, so the methods of the contract are run by
+// the generated dispatchers instead of by reflection.
+func (c *Contract) Dispatchers() map[string]ethprecompile.Dispatcher {
+	return generated.GovernanceModuleDispatchers(c)
+}
+
+// GasSchedule implements the `ethprecompile.GasScheduleImpl` interface.
+func (c *Contract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"submitProposal":                 {Base: precompile.TxBaseGas},
+		"cancelProposal":                 {Base: precompile.TxBaseGas},
+		"vote":                           {Base: precompile.TxBaseGas},
+		"voteWeighted":                   {Base: precompile.TxBaseGas},
+		"getProposal":                    {Base: precompile.QueryBaseGas},
+		"getProposals":                   {Base: precompile.PaginatedQueryBaseGas},
+		"getProposalDeposits":            {Base: precompile.PaginatedQueryBaseGas},
+		"getProposalDepositsByDepositor": {Base: precompile.PaginatedQueryBaseGas},
+		"getProposalTallyResult":         {Base: precompile.QueryBaseGas},
+		"getProposalVotes":               {Base: precompile.PaginatedQueryBaseGas},
+		"getProposalVotesByVoter":        {Base: precompile.QueryBaseGas},
+		"getParams":                      {Base: precompile.QueryBaseGas},
+		"getDepositParams":               {Base: precompile.QueryBaseGas},
+		"getVotingParams":                {Base: precompile.QueryBaseGas},
+		"getTallyParams":                 {Base: precompile.QueryBaseGas},
+		"getConstitution":                {Base: precompile.QueryBaseGas},
+	}
+}
+
 // CustomValueDecoders implements the `ethprecompile.StatefulImpl` interface.
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 	return ethprecompile.ValueDecoders{
@@ -90,7 +132,7 @@ func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 // governance precompile contract.
 func (c *Contract) SubmitProposal(
 	ctx context.Context,
-	proposal any,
+	proposal generated.IGovernanceModuleMsgSubmitProposal,
 ) (uint64, error) {
 	// Convert the submit proposal msg into v1.MsgSubmitProposal.
 	msgSubmitProposal, err := cosmlib.ConvertMsgSubmitProposalToSdk(proposal, c.ir, c.addressCodec)
@@ -137,7 +179,7 @@ func (c *Contract) CancelProposal(
 		Proposer:   caller,
 	})
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, proposalError(err, id)
 	}
 
 	return uint64(res.CanceledTime.Unix()), res.CanceledHeight, nil
@@ -164,7 +206,7 @@ func (c *Contract) Vote(
 		Option:     v1.VoteOption(options),
 		Metadata:   metadata,
 	}); err != nil {
-		return false, err
+		return false, proposalError(err, proposalID)
 	}
 
 	// Emit the proposal voted event.
@@ -223,7 +265,7 @@ func (c *Contract) VoteWeighted(
 			Metadata:   metadata,
 		},
 	); err != nil {
-		return false, err
+		return false, proposalError(err, proposalID)
 	}
 
 	// Emit the proposal voted event.
@@ -255,7 +297,7 @@ func (c *Contract) GetProposal(
 		ProposalId: proposalID,
 	})
 	if err != nil {
-		return generated.IGovernanceModuleProposal{}, err
+		return generated.IGovernanceModuleProposal{}, proposalError(err, proposalID)
 	}
 
 	return cosmlib.SdkProposalToGovProposal(res.Proposal, c.addressCodec)
@@ -267,26 +309,28 @@ func (c *Contract) GetProposal(
 func (c *Contract) GetProposals(
 	ctx context.Context,
 	proposalStatus int32,
-	pagination any,
-) ([]generated.IGovernanceModuleProposal, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IGovernanceModuleProposal, generated.CosmosPageResponse, error) {
 	res, err := c.querier.Proposals(ctx, &v1.QueryProposalsRequest{
 		ProposalStatus: v1.ProposalStatus(proposalStatus),
 		Pagination:     cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	govProposals := make([]generated.IGovernanceModuleProposal, len(res.Proposals))
 	for i, sdkProposal := range res.Proposals {
 		var govProposal generated.IGovernanceModuleProposal
 		if govProposal, err = cosmlib.SdkProposalToGovProposal(sdkProposal, c.addressCodec); err != nil {
-			return nil, cbindings.CosmosPageResponse{}, err
+			return nil, generated.CosmosPageResponse{}, err
 		}
 		govProposals[i] = govProposal
 	}
 
-	return govProposals, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+	return govProposals, cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	), nil
 }
 
 // GetProposalDeposits is the method for the `getProposalDeposits`
@@ -353,7 +397,7 @@ func (c *Contract) GetProposalTallyResult(
 		ProposalId: proposalID,
 	})
 	if err != nil {
-		return generated.IGovernanceModuleTallyResult{}, err
+		return generated.IGovernanceModuleTallyResult{}, proposalError(err, proposalID)
 	}
 
 	return generated.IGovernanceModuleTallyResult{
@@ -369,14 +413,14 @@ func (c *Contract) GetProposalTallyResult(
 func (c *Contract) GetProposalVotes(
 	ctx context.Context,
 	proposalID uint64,
-	pagination any,
-) ([]generated.IGovernanceModuleVote, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IGovernanceModuleVote, generated.CosmosPageResponse, error) {
 	res, err := c.querier.Votes(ctx, &v1.QueryVotesRequest{
 		ProposalId: proposalID,
 		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	votes := make([]generated.IGovernanceModuleVote, 0)
@@ -394,7 +438,7 @@ func (c *Contract) GetProposalVotes(
 		var voter common.Address
 		voter, err = cosmlib.EthAddressFromString(c.addressCodec, vote.Voter)
 		if err != nil {
-			return nil, cbindings.CosmosPageResponse{}, err
+			return nil, generated.CosmosPageResponse{}, err
 		}
 		votes = append(votes, generated.IGovernanceModuleVote{
 			ProposalId: proposalID,
@@ -404,7 +448,9 @@ func (c *Contract) GetProposalVotes(
 		})
 	}
 
-	return votes, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+	return votes, cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	), nil
 }
 
 // GetProposalVotesByVoter is the method for the `getProposalVotesByVoter`
@@ -566,6 +612,18 @@ func ConvertStringToVote(attributeValue string) (any, error) {
 	return vote, nil
 }
 
+// proposalError returns the custom error of `IGovernanceModule` that corresponds to the given
+// error of the proposal with the given id. Errors without a custom error are returned as is.
+func proposalError(err error, proposalID uint64) error {
+	switch {
+	case status.Code(err) == codes.NotFound, errors.Is(err, collections.ErrNotFound):
+		return ethprecompile.NewCustomError(errProposalNotFound, proposalID)
+	case errors.Is(err, govtypes.ErrInactiveProposal):
+		return ethprecompile.NewCustomError(errInactiveProposal, proposalID)
+	}
+	return err
+}
+
 // ConvertAccAddressFromString converts a Cosmos string representing a account address to a
 // common.Address.
 func (c *Contract) ConvertAccAddressFromString(attributeValue string) (any, error) {
@@ -31,7 +31,6 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
-	cbindings "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	generated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/governance"
 	testutils "github.com/berachain/polaris/cosmos/testutil"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
@@ -210,6 +209,13 @@ var _ = Describe("Governance Precompile", func() {
 			Expect(time).ToNot(BeZero())
 			Expect(height).ToNot(BeZero())
 		})
+
+		It("should fail with ProposalNotFound if the proposal does not exist", func() {
+			_, _, err := contract.CancelProposal(ctx, uint64(1000))
+			Expect(err).To(MatchError(
+				ethprecompile.NewCustomError(errProposalNotFound, uint64(1000)),
+			))
+		})
 	})
 
 	When("Voting on a proposal", func() {
@@ -231,7 +237,9 @@ var _ = Describe("Governance Precompile", func() {
 				"metadata",
 			)
 			Expect(res).To(BeFalse())
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(
+				ethprecompile.NewCustomError(errInactiveProposal, uint64(1000)),
+			))
 		})
 
 		It("should succeed", func() {
@@ -323,6 +331,13 @@ var _ = Describe("Governance Precompile", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(res).ToNot(BeNil())
 				})
+
+				It("should fail with ProposalNotFound if the proposal does not exist", func() {
+					_, err := contract.GetProposal(ctx, uint64(1000))
+					Expect(err).To(MatchError(
+						ethprecompile.NewCustomError(errProposalNotFound, uint64(1000)),
+					))
+				})
 			})
 			When("GetProposals", func() {
 				BeforeEach(func() {
@@ -334,7 +349,7 @@ var _ = Describe("Governance Precompile", func() {
 					res, pageRes, err := contract.GetProposals(
 						ctx,
 						int32(0),
-						cbindings.CosmosPageRequest{
+						generated.CosmosPageRequest{
 							Key:        "test",
 							Offset:     0,
 							Limit:      10,
@@ -418,8 +433,8 @@ var _ = Describe("Governance Precompile", func() {
 						res, pageRes, err := contract.GetProposalVotes(
 							ctx,
 							uint64(2),
-							cbindings.CosmosPageRequest{
-								Key:        "test",
+							generated.CosmosPageRequest{
+								Key:        "",
 								Offset:     0,
 								Limit:      10,
 								CountTotal: true,
@@ -22,6 +22,7 @@ package staking
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"google.golang.org/grpc/codes"
@@ -30,13 +31,14 @@ import (
 	"cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
 
-	cbindings "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	generated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/staking"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
+	"github.com/berachain/polaris/cosmos/precompile"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	pvm "github.com/berachain/polaris/eth/core/vm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -44,6 +46,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Names of the custom errors of the staking precompile, as declared in `IStakingModule`.
+const (
+	errValidatorNotFound  = "ValidatorNotFound"
+	errInsufficientFunds  = "InsufficientFunds"
+	errDelegationNotFound = "DelegationNotFound"
+)
+
 type ValidatorStore interface {
 	ValidatorAddressCodec() address.Codec
 	ValidatorByConsAddr(ctx context.Context, addr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
@@ -54,6 +63,9 @@ type ValidatorStore interface {
 	) error
 }
 
+// Contract implements the Go interface generated from the ABI of the staking precompile.
+var _ generated.StakingModulePrecompile = (*Contract)(nil)
+
 // Contract is the precompile contract for the staking module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -78,6 +90,33 @@ func NewPrecompileContract(ak cosmlib.CodecProvider, sk *stakingkeeper.Keeper) *
 	}
 }
 
+// Dispatchers implements ethprecompile.DispatcherImpl, so the methods of the contract are run by
+// the generated dispatchers instead of by reflection.
+func (c *Contract) Dispatchers() map[string]ethprecompile.Dispatcher {
+	return generated.StakingModuleDispatchers(c)
+}
+
+// GasSchedule implements the `ethprecompile.GasScheduleImpl` interface.
+func (c *Contract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"getValAddressFromConsAddress":     {Base: precompile.QueryBaseGas},
+		"getBondedValidators":              {Base: precompile.PaginatedQueryBaseGas},
+		"getBondedValidatorsByPower":       {Base: precompile.PaginatedQueryBaseGas},
+		"getValidators":                    {Base: precompile.PaginatedQueryBaseGas},
+		"getValidator":                     {Base: precompile.QueryBaseGas},
+		"getDelegatorValidators":           {Base: precompile.PaginatedQueryBaseGas},
+		"getValidatorDelegations":          {Base: precompile.PaginatedQueryBaseGas},
+		"getDelegation":                    {Base: precompile.QueryBaseGas},
+		"getUnbondingDelegation":           {Base: precompile.QueryBaseGas},
+		"getDelegatorUnbondingDelegations": {Base: precompile.PaginatedQueryBaseGas},
+		"getRedelegations":                 {Base: precompile.PaginatedQueryBaseGas},
+		"delegate":                         {Base: precompile.TxBaseGas},
+		"undelegate":                       {Base: precompile.TxBaseGas},
+		"beginRedelegate":                  {Base: precompile.TxBaseGas},
+		"cancelUnbondingDelegation":        {Base: precompile.TxBaseGas},
+	}
+}
+
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 	return ethprecompile.ValueDecoders{
 		stakingtypes.AttributeKeyDelegator:    c.ConvertAccAddressFromString,
@@ -101,23 +140,25 @@ func (c *Contract) GetValAddressFromConsAddress(
 // GetBondedValidators implements the `getBondedValidators(PageRequest)` method.
 func (c *Contract) GetBondedValidators(
 	ctx context.Context,
-	pagination any,
-) ([]generated.IStakingModuleValidator, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleValidator, generated.CosmosPageResponse, error) {
 	res, err := c.querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
 		Status:     stakingtypes.BondStatusBonded,
 		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	vals, err := cosmlib.SdkValidatorsToStakingValidators(
 		c.vs.ValidatorAddressCodec(), res.GetValidators(),
 	)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
-	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	)
 	return vals, pageResponse, nil
 }
 
@@ -154,22 +195,24 @@ func (c *Contract) GetBondedValidatorsByPower(
 // GetValidators implements the `getValidators(PageRequest)` method.
 func (c *Contract) GetValidators(
 	ctx context.Context,
-	pagination any,
-) ([]generated.IStakingModuleValidator, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleValidator, generated.CosmosPageResponse, error) {
 	res, err := c.querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
 		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	vals, err := cosmlib.SdkValidatorsToStakingValidators(
 		c.vs.ValidatorAddressCodec(), res.GetValidators(),
 	)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
-	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	)
 	return vals, pageResponse, nil
 }
 
@@ -185,7 +228,11 @@ func (c *Contract) GetValidator(
 	res, err := c.querier.Validator(ctx, &stakingtypes.QueryValidatorRequest{
 		ValidatorAddr: valAddr,
 	})
-	if err != nil {
+	if status.Code(err) == codes.NotFound {
+		return generated.IStakingModuleValidator{}, ethprecompile.NewCustomError(
+			errValidatorNotFound, validatorAddress,
+		)
+	} else if err != nil {
 		return generated.IStakingModuleValidator{}, err
 	}
 
@@ -204,48 +251,50 @@ func (c *Contract) GetValidator(
 func (c *Contract) GetDelegatorValidators(
 	ctx context.Context,
 	delegatorAddr common.Address,
-	pagination any,
-) ([]generated.IStakingModuleValidator, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleValidator, generated.CosmosPageResponse, error) {
 	delegator, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddr)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 	res, err := c.querier.DelegatorValidators(ctx, &stakingtypes.QueryDelegatorValidatorsRequest{
 		DelegatorAddr: delegator,
 		Pagination:    cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	vals, err := cosmlib.SdkValidatorsToStakingValidators(
 		c.vs.ValidatorAddressCodec(), res.GetValidators(),
 	)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
-	return vals, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+	return vals, cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	), nil
 }
 
 // GetValidatorDelegations implements the `getValidatorDelegations(address,PageRequest)` method.
 func (c *Contract) GetValidatorDelegations(
 	ctx context.Context,
 	validatorAddress common.Address,
-	pagination any,
-) ([]generated.IStakingModuleDelegation, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleDelegation, generated.CosmosPageResponse, error) {
 	valAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), validatorAddress)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 	res, err := c.querier.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
 		ValidatorAddr: valAddr,
 		Pagination:    cosmlib.ExtractPageRequestFromInput(pagination),
 	})
 	if status.Code(err) == codes.NotFound {
-		return []generated.IStakingModuleDelegation{}, cbindings.CosmosPageResponse{}, nil
+		return []generated.IStakingModuleDelegation{}, generated.CosmosPageResponse{}, nil
 	} else if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	delegations := make([]generated.IStakingModuleDelegation, 0)
@@ -253,7 +302,7 @@ func (c *Contract) GetValidatorDelegations(
 		var delegator common.Address
 		delegator, err = cosmlib.EthAddressFromString(c.accAddrCodec, d.Delegation.DelegatorAddress)
 		if err != nil {
-			return nil, cbindings.CosmosPageResponse{}, err
+			return nil, generated.CosmosPageResponse{}, err
 		}
 		delegations = append(delegations, generated.IStakingModuleDelegation{
 			Delegator: delegator,
@@ -262,7 +311,9 @@ func (c *Contract) GetValidatorDelegations(
 		})
 	}
 
-	return delegations, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+	return delegations, cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	), nil
 }
 
 // GetDelegation implements `getDelegation(address)` method.
@@ -332,11 +383,11 @@ func (c *Contract) GetUnbondingDelegation(
 func (c *Contract) GetDelegatorUnbondingDelegations(
 	ctx context.Context,
 	delegatorAddress common.Address,
-	pagination any,
-) ([]generated.IStakingModuleUnbondingDelegation, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleUnbondingDelegation, generated.CosmosPageResponse, error) {
 	delAddr, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddress)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	res, err := c.querier.DelegatorUnbondingDelegations(ctx,
@@ -346,9 +397,9 @@ func (c *Contract) GetDelegatorUnbondingDelegations(
 		})
 	if status.Code(err) == codes.NotFound {
 		return []generated.IStakingModuleUnbondingDelegation{},
-			cbindings.CosmosPageResponse{}, nil
+			generated.CosmosPageResponse{}, nil
 	} else if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	unbondingDelegations := make([]generated.IStakingModuleUnbondingDelegation, 0)
@@ -361,11 +412,11 @@ func (c *Contract) GetDelegatorUnbondingDelegations(
 		valAddr, err = cosmlib.EthAddressFromString(
 			c.vs.ValidatorAddressCodec(), u.ValidatorAddress)
 		if err != nil {
-			return nil, cbindings.CosmosPageResponse{}, err
+			return nil, generated.CosmosPageResponse{}, err
 		}
 		delegator, err = cosmlib.EthAddressFromString(c.accAddrCodec, u.DelegatorAddress)
 		if err != nil {
-			return nil, cbindings.CosmosPageResponse{}, err
+			return nil, generated.CosmosPageResponse{}, err
 		}
 
 		unbondingDelegations = append(unbondingDelegations,
@@ -377,7 +428,10 @@ func (c *Contract) GetDelegatorUnbondingDelegations(
 		)
 	}
 
-	return unbondingDelegations, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](
+		res.Pagination,
+	)
+	return unbondingDelegations, pageResponse, nil
 }
 
 // GetRedelegations implements the `getRedelegations(address,address)` method.
@@ -386,19 +440,19 @@ func (c *Contract) GetRedelegations(
 	delegatorAddress common.Address,
 	srcValidator common.Address,
 	dstValidator common.Address,
-	pagination any,
-) ([]generated.IStakingModuleRedelegationEntry, cbindings.CosmosPageResponse, error) {
+	pagination generated.CosmosPageRequest,
+) ([]generated.IStakingModuleRedelegationEntry, generated.CosmosPageResponse, error) {
 	delAddr, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddress)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 	srcValAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), srcValidator)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 	destValAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), dstValidator)
 	if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	rsp, err := c.querier.Redelegations(
@@ -411,9 +465,9 @@ func (c *Contract) GetRedelegations(
 		},
 	)
 	if status.Code(err) == codes.NotFound {
-		return []generated.IStakingModuleRedelegationEntry{}, cbindings.CosmosPageResponse{}, nil
+		return []generated.IStakingModuleRedelegationEntry{}, generated.CosmosPageResponse{}, nil
 	} else if err != nil {
-		return nil, cbindings.CosmosPageResponse{}, err
+		return nil, generated.CosmosPageResponse{}, err
 	}
 
 	var redelegationEntryResponses []stakingtypes.RedelegationEntryResponse
@@ -434,7 +488,7 @@ func (c *Contract) GetRedelegations(
 	}
 
 	return cosmlib.SdkREToStakingRE(redelegationEntries),
-		cosmlib.SdkPageResponseToEvmPageResponse(rsp.Pagination),
+		cosmlib.SdkPageResponseToEvmPageResponse[generated.CosmosPageResponse](rsp.Pagination),
 		err
 }
 
@@ -464,7 +518,9 @@ func (c *Contract) Delegate(
 		valAddr,
 		sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
 	))
-	return err == nil, err
+	return err == nil, stakingError(
+		err, pvm.UnwrapPolarContext(ctx).MsgSender(), validatorAddress, amount,
+	)
 }
 
 // Undelegate implements the `undelegate(address,uint256)` method.
@@ -493,7 +549,9 @@ func (c *Contract) Undelegate(
 		valAddr,
 		sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
 	))
-	return err == nil, err
+	return err == nil, stakingError(
+		err, pvm.UnwrapPolarContext(ctx).MsgSender(), validatorAddress, amount,
+	)
 }
 
 // BeginRedelegate implements the `beginRedelegate(address,address,uint256)` method.
@@ -531,7 +589,15 @@ func (c *Contract) BeginRedelegate(
 			sdk.Coin{Denom: bondDenom, Amount: sdkmath.NewIntFromBigInt(amount)},
 		),
 	)
-	return err == nil, err
+	switch {
+	case errors.Is(err, stakingtypes.ErrBadRedelegationSrc):
+		return false, ethprecompile.NewCustomError(errValidatorNotFound, srcValidator)
+	case errors.Is(err, stakingtypes.ErrBadRedelegationDst):
+		return false, ethprecompile.NewCustomError(errValidatorNotFound, dstValidator)
+	}
+	return err == nil, stakingError(
+		err, pvm.UnwrapPolarContext(ctx).MsgSender(), srcValidator, amount,
+	)
 }
 
 // CancelRedelegate implements the `cancelRedelegate(address,address,uint256,int64)` method.
@@ -565,7 +631,25 @@ func (c *Contract) CancelUnbondingDelegation(
 			sdk.Coin{Denom: bondDenom, Amount: sdkmath.NewIntFromBigInt(amount)},
 		),
 	)
-	return err != nil, err
+	return err != nil, stakingError(
+		err, pvm.UnwrapPolarContext(ctx).MsgSender(), validatorAddress, amount,
+	)
+}
+
+// stakingError returns the custom error of `IStakingModule` that corresponds to the given error of
+// a staking message, sent by the delegator for the given amount of the validator. Errors without a
+// custom error are returned as is.
+func stakingError(err error, delegator, validator common.Address, amount *big.Int) error {
+	switch {
+	case errors.Is(err, stakingtypes.ErrNoValidatorFound):
+		return ethprecompile.NewCustomError(errValidatorNotFound, validator)
+	case errors.Is(err, sdkerrors.ErrInsufficientFunds):
+		return ethprecompile.NewCustomError(errInsufficientFunds, delegator, amount)
+	case errors.Is(err, stakingtypes.ErrNoDelegation),
+		errors.Is(err, stakingtypes.ErrNoDelegatorForAddress):
+		return ethprecompile.NewCustomError(errDelegationNotFound, delegator, validator)
+	}
+	return err
 }
 
 // bondDenom returns the bond denom from the staking module.
@@ -28,7 +28,6 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
-	cbindings "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	generated "github.com/berachain/polaris/contracts/bindings/cosmos/precompile/staking"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
 	testutil "github.com/berachain/polaris/cosmos/testutil"
@@ -249,6 +248,30 @@ var _ = Describe("Staking", func() {
 				)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("should fail with InsufficientFunds if the delegator lacks the amount", func() {
+				amount := big.NewInt(1000)
+				_, err := contract.Delegate(ctx, valAddr, amount)
+				Expect(err).To(MatchError(
+					ethprecompile.NewCustomError(errInsufficientFunds, caller, amount),
+				))
+			})
+
+			It("should fail with ValidatorNotFound if the validator does not exist", func() {
+				_, err := contract.Delegate(ctx, common.Address{0x01}, big.NewInt(1000))
+				Expect(err).To(MatchError(
+					ethprecompile.NewCustomError(errValidatorNotFound, common.Address{0x01}),
+				))
+			})
+		})
+
+		When("GetValidator", func() {
+			It("should fail with ValidatorNotFound if the validator does not exist", func() {
+				_, err := contract.GetValidator(ctx, common.Address{0x01})
+				Expect(err).To(MatchError(
+					ethprecompile.NewCustomError(errValidatorNotFound, common.Address{0x01}),
+				))
+			})
 		})
 
 		When("GetDelegation", func() {
@@ -268,7 +291,7 @@ var _ = Describe("Staking", func() {
 				res, _, err := contract.GetValidatorDelegations(
 					ctx,
 					valAddr,
-					cbindings.CosmosPageRequest{
+					generated.CosmosPageRequest{
 						Key:        "test",
 						Offset:     0,
 						Limit:      10,
@@ -286,7 +309,7 @@ var _ = Describe("Staking", func() {
 				res, _, err := contract.GetValidatorDelegations(
 					ctx,
 					valAddr,
-					cbindings.CosmosPageRequest{},
+					generated.CosmosPageRequest{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(res).To(HaveLen(1))
@@ -383,7 +406,7 @@ var _ = Describe("Staking", func() {
 				res, _, err := contract.GetDelegatorUnbondingDelegations(
 					ctx,
 					caller,
-					cbindings.CosmosPageRequest{
+					generated.CosmosPageRequest{
 						Key:        "test",
 						Offset:     0,
 						Limit:      10,
@@ -466,7 +489,7 @@ var _ = Describe("Staking", func() {
 					caller,
 					valAddr,
 					otherValAddr,
-					cbindings.CosmosPageRequest{},
+					generated.CosmosPageRequest{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(redels).ToNot(BeNil())
@@ -560,7 +583,7 @@ var _ = Describe("Staking", func() {
 							common.BytesToAddress([]byte("")),
 							valAddr,
 							otherValAddr,
-							cbindings.CosmosPageRequest{},
+							generated.CosmosPageRequest{},
 						)
 						Expect(err).To(HaveOccurred())
 					})
@@ -571,7 +594,7 @@ var _ = Describe("Staking", func() {
 							caller,
 							valAddr,
 							otherValAddr,
-							cbindings.CosmosPageRequest{},
+							generated.CosmosPageRequest{},
 						)
 						Expect(err).To(HaveOccurred())
 					})
@@ -600,7 +623,7 @@ var _ = Describe("Staking", func() {
 				Expect(sk.SetValidator(ctx, validator)).To(Succeed())
 
 				// Get the active validators.
-				res, _, err := contract.GetBondedValidators(ctx, cbindings.CosmosPageRequest{})
+				res, _, err := contract.GetBondedValidators(ctx, generated.CosmosPageRequest{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(res).To(HaveLen(1))
 				Expect(res[0].OperatorAddr).To(Equal(valAddr))
@@ -23,6 +23,7 @@ package chain
 import (
 	"fmt"
 
+	"github.com/berachain/polaris/cosmos/runtime/comet"
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -40,9 +41,18 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 		err error
 	)
 
+	// Pull the extended commit of the last block out of the proposal.
+	extCommit, txs, err := comet.ExtractExtendedCommit(ctx, req.ProposedLastCommit, req.Txs)
+	if err != nil {
+		ctx.Logger().Error("invalid extended commit", "err", err)
+		return &abci.ResponseProcessProposal{
+			Status: abci.ResponseProcessProposal_REJECT,
+		}, err
+	}
+
 	// Pull an execution payload out of the proposal.
 	var envelope *engine.ExecutionPayloadEnvelope
-	for _, tx := range req.Txs {
+	for _, tx := range txs {
 		var sdkTx sdk.Tx
 		sdkTx, err = wbc.app.TxDecode(tx)
 		if err != nil {
@@ -69,13 +79,22 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 
 	// Convert it to a block.
 	var block *ethtypes.Block
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
+	if block, err = evmtypes.ExecutableDataToBlock(ctx, wbc.Config(), envelope); err != nil {
 		ctx.Logger().Error("failed to build evm block", "err", err)
 		return &abci.ResponseProcessProposal{
 			Status: abci.ResponseProcessProposal_REJECT,
 		}, err
 	}
 
+	// Verify the block against the Comet block, and keep it as a bad block if it is invalid.
+	if err = wbc.verifyBlock(ctx, block, extCommit); err != nil {
+		ctx.Logger().Error("invalid evm block", "err", err)
+		wbc.ReportBlock(block, err)
+		return &abci.ResponseProcessProposal{
+			Status: abci.ResponseProcessProposal_REJECT,
+		}, err
+	}
+
 	// Insert the block into the chain.
 	if err = wbc.InsertBlock(block); err != nil {
 		ctx.Logger().Error("failed to insert block", "err", err)
@@ -88,3 +107,33 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 		Status: abci.ResponseProcessProposal_ACCEPT,
 	}, nil
 }
+
+// verifyBlock verifies the header of the block against its parent and the time of the Comet
+// block, and verifies that the fees of the block are paid to the proposer and that its random
+// value is derived from the extended commit of the last block.
+func (wbc *WrappedBlockchain) verifyBlock(
+	ctx sdk.Context, block *ethtypes.Block, extCommit abci.ExtendedCommitInfo,
+) error {
+	if err := wbc.Engine().VerifyHeader(wbc, block.Header()); err != nil {
+		return err
+	} else if blockTime := uint64(ctx.BlockTime().Unix()); block.Time() != blockTime {
+		return fmt.Errorf("timestamp mismatch, want %d, got %d", blockTime, block.Time())
+	}
+
+	if coinbase, err := wbc.coinbase.Coinbase(ctx); err != nil {
+		return err
+	} else if block.Coinbase() != coinbase {
+		return fmt.Errorf(
+			"fee recipient mismatch, want %s, got %s", coinbase.Hex(), block.Coinbase().Hex(),
+		)
+	}
+
+	if random, err := wbc.random.Random(ctx, extCommit); err != nil {
+		return err
+	} else if block.MixDigest() != random {
+		return fmt.Errorf(
+			"prevrandao mismatch, want %s, got %s", random.Hex(), block.MixDigest().Hex(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/runtime/chain"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProcessProposal", func() {
+	var (
+		bc       *mockChain
+		random   *mockRandom
+		wbc      *chain.WrappedBlockchain
+		decoder  *mockDecoder
+		ctx      sdk.Context
+		coinbase = common.Address{1}
+		envelope = []byte("envelope")
+	)
+
+	BeforeEach(func() {
+		config := *params.TestChainConfig
+		config.ShanghaiTime, config.CancunTime = nil, nil
+		bc = &mockChain{config: &config}
+		random = &mockRandom{value: common.Hash{2}}
+		ctx = sdk.Context{}.WithLogger(log.NewNopLogger()).WithBlockHeader(cmtproto.Header{
+			Height: 10,
+			Time:   time.Unix(100, 0),
+		})
+
+		decoder = &mockDecoder{txs: make(map[string]sdk.Msg)}
+		wbc = chain.New(bc, decoder, mockCoinbase(coinbase), random)
+	})
+
+	// proposalOf returns a proposal of a block with the given time, coinbase and random value.
+	proposalOf := func(
+		time uint64, coinbase common.Address, mixDigest common.Hash,
+	) *abci.RequestProcessProposal {
+		block := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number:     big.NewInt(1),
+			Time:       time,
+			Coinbase:   coinbase,
+			MixDigest:  mixDigest,
+			GasLimit:   30_000_000,
+			BaseFee:    big.NewInt(1),
+			Difficulty: new(big.Int),
+			UncleHash:  ethtypes.EmptyUncleHash,
+			TxHash:     ethtypes.EmptyTxsHash,
+		})
+		payload, err := evmtypes.WrapPayload(engine.BlockToExecutableData(block, new(big.Int), nil))
+		Expect(err).ToNot(HaveOccurred())
+		decoder.txs[string(envelope)] = payload
+		return &abci.RequestProcessProposal{Txs: [][]byte{envelope}}
+	}
+
+	// proposal returns a proposal of a valid block with the given random value.
+	proposal := func(mixDigest common.Hash) *abci.RequestProcessProposal {
+		return proposalOf(100, coinbase, mixDigest)
+	}
+
+	It("should accept a block with the expected random value", func() {
+		res, err := wbc.ProcessProposal(ctx, proposal(random.value))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+		Expect(bc.inserted).To(HaveLen(1))
+		Expect(bc.reported).To(BeEmpty())
+	})
+
+	It("should reject and report a block with an invalid header", func() {
+		bc.headerErr = errors.New("invalid header")
+		res, err := wbc.ProcessProposal(ctx, proposal(random.value))
+		Expect(err).To(MatchError(bc.headerErr))
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+		Expect(bc.reported).To(HaveLen(1))
+	})
+
+	It("should reject and report a block with another timestamp", func() {
+		res, err := wbc.ProcessProposal(ctx, proposalOf(101, coinbase, random.value))
+		Expect(err).To(MatchError(ContainSubstring("timestamp mismatch")))
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+		Expect(bc.reported).To(HaveLen(1))
+	})
+
+	It("should reject and report a block with another coinbase", func() {
+		res, err := wbc.ProcessProposal(ctx, proposalOf(100, common.Address{2}, random.value))
+		Expect(err).To(MatchError(ContainSubstring("fee recipient mismatch")))
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+		Expect(bc.reported).To(HaveLen(1))
+	})
+
+	It("should reject and report a block with another random value", func() {
+		res, err := wbc.ProcessProposal(ctx, proposal(common.Hash{3}))
+		Expect(err).To(MatchError(ContainSubstring("prevrandao mismatch")))
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+		Expect(bc.reported).To(HaveLen(1))
+	})
+
+	It("should reject and report a block if the random value cannot be resolved", func() {
+		random.err = errors.New("no randomness")
+		res, err := wbc.ProcessProposal(ctx, proposal(random.value))
+		Expect(err).To(MatchError(random.err))
+		Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+		Expect(bc.inserted).To(BeEmpty())
+		Expect(bc.reported).To(HaveLen(1))
+	})
+
+	When("vote extensions are enabled", func() {
+		var lastCommit abci.CommitInfo
+		var extCommit abci.ExtendedCommitInfo
+
+		BeforeEach(func() {
+			ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+			})
+			validator := abci.Validator{Address: []byte{1}, Power: 1}
+			lastCommit = abci.CommitInfo{Votes: []abci.VoteInfo{
+				{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			}}
+			extCommit = abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{{
+				Validator:          validator,
+				BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+				VoteExtension:      []byte{2},
+				ExtensionSignature: []byte{3},
+			}}}
+		})
+
+		// withExtendedCommit includes the extended commit in the proposal.
+		withExtendedCommit := func(req *abci.RequestProcessProposal) *abci.RequestProcessProposal {
+			bz, err := extCommit.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			req.Txs = append([][]byte{bz}, req.Txs...)
+			req.ProposedLastCommit = lastCommit
+			return req
+		}
+
+		It("should verify the random value against the extended commit", func() {
+			res, err := wbc.ProcessProposal(ctx, withExtendedCommit(proposal(random.value)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Status).To(Equal(abci.ResponseProcessProposal_ACCEPT))
+			Expect(random.extCommit).To(Equal(extCommit))
+		})
+
+		It("should reject a proposal without the extended commit", func() {
+			req := proposal(random.value)
+			req.ProposedLastCommit = lastCommit
+			res, err := wbc.ProcessProposal(ctx, req)
+			Expect(err).To(HaveOccurred())
+			Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+			Expect(bc.inserted).To(BeEmpty())
+		})
+
+		It("should reject an extended commit that does not match the last commit", func() {
+			extCommit.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+			res, err := wbc.ProcessProposal(ctx, withExtendedCommit(proposal(random.value)))
+			Expect(err).To(MatchError(ContainSubstring("does not match the last commit")))
+			Expect(res.Status).To(Equal(abci.ResponseProcessProposal_REJECT))
+			Expect(bc.inserted).To(BeEmpty())
+		})
+	})
+})
+
+// MOCKS BELOW.
+
+type mockChain struct {
+	core.Blockchain
+	config    *params.ChainConfig
+	headerErr error
+	inserted  []*ethtypes.Block
+	reported  []*ethtypes.Block
+}
+
+func (m *mockChain) Config() *params.ChainConfig {
+	return m.config
+}
+
+func (m *mockChain) Engine() consensus.Engine {
+	return mockEngine{err: m.headerErr}
+}
+
+func (m *mockChain) InsertBlock(block *ethtypes.Block) error {
+	m.inserted = append(m.inserted, block)
+	return nil
+}
+
+func (m *mockChain) ReportBlock(block *ethtypes.Block, _ error) {
+	m.reported = append(m.reported, block)
+}
+
+type mockEngine struct {
+	consensus.Engine
+	err error
+}
+
+func (m mockEngine) VerifyHeader(consensus.ChainHeaderReader, *ethtypes.Header) error {
+	return m.err
+}
+
+type mockRandom struct {
+	value     common.Hash
+	err       error
+	extCommit abci.ExtendedCommitInfo
+}
+
+func (m *mockRandom) Random(
+	_ sdk.Context, extCommit abci.ExtendedCommitInfo,
+) (common.Hash, error) {
+	m.extCommit = extCommit
+	return m.value, m.err
+}
+
+type mockCoinbase common.Address
+
+func (m mockCoinbase) Coinbase(sdk.Context) (common.Address, error) {
+	return common.Address(m), nil
+}
+
+// mockDecoder decodes the transactions in its map to transactions of a single message.
+type mockDecoder struct {
+	txs map[string]sdk.Msg
+}
+
+func (m *mockDecoder) TxDecode(bz []byte) (sdk.Tx, error) {
+	if msg, ok := m.txs[string(bz)]; ok {
+		return mockTx{msg}, nil
+	}
+	return nil, errors.New("unknown tx")
+}
+
+type mockTx struct {
+	msg sdk.Msg
+}
+
+func (m mockTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{m.msg}
+}
+
+func (m mockTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, errors.New("not implemented")
+}
@@ -27,14 +27,19 @@ import (
 // WrappedBlockchain is a struct that wraps the core blockchain with additional
 // application context.
 type WrappedBlockchain struct {
-	core.Blockchain           // chain is the core blockchain.
-	app             txDecoder // App is the application context.
+	core.Blockchain                  // chain is the core blockchain.
+	app             txDecoder        // App is the application context.
+	coinbase        coinbaseResolver // coinbase resolves the fee recipient of proposals.
+	random          randomnessSource // random provides the random value of proposals.
 }
 
-// New creates a new instance of WrappedBlockchain with the provided core blockchain
-// and application context.
-func New(chain core.Blockchain, app txDecoder) *WrappedBlockchain {
-	return &WrappedBlockchain{Blockchain: chain, app: app}
+// New creates a new instance of WrappedBlockchain with the provided core blockchain,
+// application context, coinbase resolver and randomness source. The coinbase resolver and the
+// randomness source must not be nil, as every proposal is verified against them.
+func New(
+	chain core.Blockchain, app txDecoder, coinbase coinbaseResolver, random randomnessSource,
+) *WrappedBlockchain {
+	return &WrappedBlockchain{Blockchain: chain, app: app, coinbase: coinbase, random: random}
 }
 
 func (wbc *WrappedBlockchain) SetBlockchain(chain core.Blockchain) {
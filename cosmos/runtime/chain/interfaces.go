@@ -21,9 +21,25 @@
 package chain
 
 import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type txDecoder interface {
 	TxDecode(txBz []byte) (sdk.Tx, error)
 }
+
+// coinbaseResolver resolves the coinbase (fee recipient) of the block proposed in the given
+// context.
+type coinbaseResolver interface {
+	Coinbase(sdk.Context) (common.Address, error)
+}
+
+// randomnessSource provides the PREVRANDAO value of the block proposed in the given context, from
+// the extended commit of the last block.
+type randomnessSource interface {
+	Random(sdk.Context, abci.ExtendedCommitInfo) (common.Hash, error)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StakingKeeper defines the methods of the staking keeper that are required to resolve the
+// validator proposing a block and to verify the vote extensions of validators.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	GetValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.Validator, error)
+	GetPubKeyByConsAddr(context.Context, sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
+}
+
+// ProposerResolver resolves the EVM address of the CometBFT block proposer, which is used as the
+// coinbase (fee recipient) of the EVM block.
+type ProposerResolver struct {
+	sk StakingKeeper
+}
+
+// NewProposerResolver returns a new ProposerResolver backed by the given staking keeper.
+func NewProposerResolver(sk StakingKeeper) *ProposerResolver {
+	return &ProposerResolver{
+		sk: sk,
+	}
+}
+
+// Coinbase returns the EVM address of the operator of the validator proposing the block in the
+// given context. The consensus address of the proposer is mapped to its validator, whose operator
+// address shares its bytes with the EVM address of the operator account.
+func (pr *ProposerResolver) Coinbase(ctx sdk.Context) (common.Address, error) {
+	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	validator, err := pr.sk.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return common.Address{}, errorslib.Wrapf(
+			err, "Coinbase: failed to get validator of proposer %s", consAddr,
+		)
+	}
+
+	operator, err := pr.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return common.Address{}, errorslib.Wrapf(
+			err, "Coinbase: invalid operator address %s", validator.GetOperator(),
+		)
+	}
+	return common.BytesToAddress(operator), nil
+}
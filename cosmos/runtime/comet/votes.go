@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionsEnabled reports whether the votes of the last commit of the block in the given
+// context carry vote extensions. CometBFT only requests vote extensions after the enable height,
+// so the block at the enable height is the first to vote on extensions and the next block is the
+// first to receive them.
+func VoteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 &&
+		ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// InjectExtendedCommit prepends the extended commit of the last block to the transactions of a
+// proposal, so that the validators processing the proposal can verify the vote extensions it was
+// built from. The transactions are returned unchanged if vote extensions are not enabled.
+func InjectExtendedCommit(
+	ctx sdk.Context, extCommit abci.ExtendedCommitInfo, txs [][]byte,
+) ([][]byte, error) {
+	if !VoteExtensionsEnabled(ctx) {
+		return txs, nil
+	}
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{bz}, txs...), nil
+}
+
+// ExtractExtendedCommit is the inverse of InjectExtendedCommit. It returns the extended commit of
+// the last block and the remaining transactions of a proposal. The extended commit must agree
+// with the last commit of the proposed block, which is verified by CometBFT.
+func ExtractExtendedCommit(
+	ctx sdk.Context, lastCommit abci.CommitInfo, txs [][]byte,
+) (abci.ExtendedCommitInfo, [][]byte, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !VoteExtensionsEnabled(ctx) {
+		return extCommit, txs, nil
+	}
+
+	if len(txs) == 0 {
+		return extCommit, nil, errors.New("proposal is missing the extended commit")
+	}
+	if err := extCommit.Unmarshal(txs[0]); err != nil {
+		return extCommit, nil, fmt.Errorf("invalid extended commit in proposal: %w", err)
+	}
+	if err := matchLastCommit(extCommit, lastCommit); err != nil {
+		return extCommit, nil, err
+	}
+	return extCommit, txs[1:], nil
+}
+
+// matchLastCommit verifies that the extended commit holds the same votes as the last commit, so
+// that the voting power of the vote extensions is the one verified by CometBFT.
+func matchLastCommit(extCommit abci.ExtendedCommitInfo, lastCommit abci.CommitInfo) error {
+	if extCommit.Round != lastCommit.Round || len(extCommit.Votes) != len(lastCommit.Votes) {
+		return errors.New("extended commit does not match the last commit")
+	}
+	for i, vote := range extCommit.Votes {
+		if vote.Validator.Power != lastCommit.Votes[i].Validator.Power ||
+			!bytes.Equal(vote.Validator.Address, lastCommit.Votes[i].Validator.Address) ||
+			vote.BlockIdFlag != lastCommit.Votes[i].BlockIdFlag {
+			return fmt.Errorf("extended commit does not match the last commit at vote %d", i)
+		}
+	}
+	return nil
+}
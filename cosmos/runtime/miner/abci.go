@@ -22,6 +22,8 @@
 package miner
 
 import (
+	"github.com/berachain/polaris/cosmos/runtime/comet"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,7 +41,7 @@ func (m *Miner) PrepareProposal(
 	)
 
 	// Trigger the geth miner to build a block.
-	if payloadEnvelopeBz, ethGasUsed, err = m.buildBlock(ctx); err != nil {
+	if payloadEnvelopeBz, ethGasUsed, err = m.buildBlock(ctx, req.LocalLastCommit); err != nil {
 		return nil, err
 	}
 
@@ -56,6 +58,11 @@ func (m *Miner) PrepareProposal(
 		allTxs = append(allTxs, valTxs...)
 	}
 
+	// Include the extended commit that the random value of the payload is derived from.
+	if allTxs, err = comet.InjectExtendedCommit(ctx, req.LocalLastCommit, allTxs); err != nil {
+		return nil, err
+	}
+
 	// Return the payload and validator transactions as a transaction in the proposal.
 	return &abci.ResponsePrepareProposal{Txs: allTxs}, err
 }
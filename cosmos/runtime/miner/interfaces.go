@@ -21,11 +21,17 @@
 package miner
 
 import (
-	"github.com/berachain/polaris/eth/core"
+	pcore "github.com/berachain/polaris/eth/core"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/event"
 )
 
 // EnvelopeSerializer is used to convert an envelope into a byte slice that represents
@@ -39,10 +45,30 @@ type (
 		TxDecode(txBytes []byte) (sdk.Tx, error)
 	}
 
+	// TxPool is the txpool from which the pending block is built.
+	TxPool interface {
+		Pending(enforceTips bool) map[common.Address][]*txpool.LazyTransaction
+		SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+	}
+
+	// CoinbaseResolver resolves the coinbase (fee recipient) of the block proposed in the given
+	// context.
+	CoinbaseResolver interface {
+		Coinbase(sdk.Context) (common.Address, error)
+	}
+
+	// RandomnessSource provides the PREVRANDAO value of the block proposed in the given context,
+	// from the extended commit of the last block. The value must be reproducible by every
+	// validator from the same context and extended commit, so that it can be verified when
+	// processing the proposal.
+	RandomnessSource interface {
+		Random(sdk.Context, abci.ExtendedCommitInfo) (common.Hash, error)
+	}
+
 	// EVMKeeper is an interface that defines the methods needed for the EVM setup.
 	EVMKeeper interface {
 		// Setup initializes the EVM keeper.
-		Setup(core.Blockchain) error
-		GetHost() core.PolarisHostChain
+		Setup(pcore.Blockchain) error
+		GetHost() pcore.PolarisHostChain
 	}
 )
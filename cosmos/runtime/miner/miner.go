@@ -29,23 +29,29 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth"
 	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/core/state"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/miner"
 )
 
 // Miner implements the baseapp.TxSelector interface.
 type Miner struct {
-	miner eth.Miner
-	app   TxDecoder
-	bc    core.Blockchain
+	miner    eth.Miner
+	app      TxDecoder
+	bc       core.Blockchain
+	txPool   TxPool
+	coinbase CoinbaseResolver
+	random   RandomnessSource
 
 	valTxSelector  baseapp.TxSelector
 	serializer     EnvelopeSerializer
@@ -53,20 +59,34 @@ type Miner struct {
 	currentPayload *miner.Payload
 
 	blockBuilderMu *sync.RWMutex
+
+	// pendingMu protects the pending block, receipts and state, which are continuously rebuilt
+	// from the txpool against the latest query context.
+	pendingMu       sync.RWMutex
+	pendingBlock    *ethtypes.Block
+	pendingReceipts ethtypes.Receipts
+	pendingState    state.StateDB
+	stopCh          chan struct{}
 }
 
-// New produces a cosmos miner from a geth miner.
+// New produces a cosmos miner from a geth miner. The coinbase and the random value of the built
+// blocks are resolved by the given coinbase and randomness sources, which must not be nil.
 func New(
 	miner eth.Miner, app TxDecoder, allowedValMsgs map[string]sdk.Msg,
-	bc core.Blockchain, blockBuilderMu *sync.RWMutex,
+	bc core.Blockchain, txPool TxPool, coinbase CoinbaseResolver, random RandomnessSource,
+	blockBuilderMu *sync.RWMutex,
 ) *Miner {
 	return &Miner{
 		miner:          miner,
 		app:            app,
 		bc:             bc,
+		txPool:         txPool,
+		coinbase:       coinbase,
+		random:         random,
 		allowedValMsgs: allowedValMsgs,
 		valTxSelector:  baseapp.NewDefaultTxSelector(),
 		blockBuilderMu: blockBuilderMu,
+		stopCh:         make(chan struct{}),
 	}
 }
 
@@ -77,7 +97,9 @@ func (m *Miner) Init(serializer EnvelopeSerializer) {
 
 // buildBlock builds and submits a payload, it also waits for the txs
 // to resolve from the underlying worker.
-func (m *Miner) buildBlock(ctx sdk.Context) ([]byte, uint64, error) {
+func (m *Miner) buildBlock(
+	ctx sdk.Context, extCommit abci.ExtendedCommitInfo,
+) ([]byte, uint64, error) {
 	defer m.clearPayload()
 
 	// Record the time it takes to build a payload.
@@ -88,7 +110,7 @@ func (m *Miner) buildBlock(ctx sdk.Context) ([]byte, uint64, error) {
 	defer m.blockBuilderMu.Unlock()
 
 	// Submit payload for building with the given context.
-	if err := m.submitPayloadForBuilding(ctx); err != nil {
+	if err := m.submitPayloadForBuilding(ctx, extCommit); err != nil {
 		return nil, 0, err
 	}
 	env, gasUsed := m.resolveEnvelope()
@@ -97,18 +119,25 @@ func (m *Miner) buildBlock(ctx sdk.Context) ([]byte, uint64, error) {
 }
 
 // submitPayloadForBuilding submits a payload for building.
-func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
+func (m *Miner) submitPayloadForBuilding(
+	ctx context.Context, extCommit abci.ExtendedCommitInfo,
+) error {
 	var (
 		err         error
 		payload     *miner.Payload
+		payloadArgs *miner.BuildPayloadArgs
 		sCtx        = sdk.UnwrapSDKContext(ctx)
-		payloadArgs = m.constructPayloadArgs(uint64(sCtx.BlockTime().Unix()))
 	)
-
 	// Set the mining context for geth to build the payload with.
 	m.bc.StatePluginFactory().SetLatestMiningContext(ctx)
+	m.bc.SetMiningTime(uint64(sCtx.BlockTime().Unix()))
 	m.bc.PrimePlugins(ctx)
 
+	if payloadArgs, err = m.constructPayloadArgs(sCtx, extCommit); err != nil {
+		sCtx.Logger().Error("failed to construct payload args", "err", err)
+		return err
+	}
+
 	// Build Payload.
 	if payload, err = m.miner.BuildPayload(payloadArgs); err != nil {
 		sCtx.Logger().Error("failed to build payload", "err", err)
@@ -119,15 +148,34 @@ func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
 	return nil
 }
 
-// constructPayloadArgs builds a payload to submit to the miner.
-func (m *Miner) constructPayloadArgs(blockTime uint64) *miner.BuildPayloadArgs {
-	return &miner.BuildPayloadArgs{
-		Timestamp:    blockTime,
-		FeeRecipient: m.miner.Etherbase(),
-		Random:       common.Hash{}, /* todo: generated random */
-		Withdrawals:  make(ethtypes.Withdrawals, 0),
-		BeaconRoot:   nil, // Add this when implementing Cancun.
+// constructPayloadArgs builds a payload to submit to the miner. The fee recipient of the payload
+// is the proposer of the Comet block, its random value is provided by the randomness source from
+// the extended commit of the last block and its withdrawals are the ones queued by the host chain.
+// The plugins must be primed beforehand.
+func (m *Miner) constructPayloadArgs(
+	ctx sdk.Context, extCommit abci.ExtendedCommitInfo,
+) (*miner.BuildPayloadArgs, error) {
+	coinbase, err := m.coinbase.Coinbase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	random, err := m.random.Random(ctx, extCommit)
+	if err != nil {
+		return nil, err
 	}
+	withdrawals, err := m.bc.PendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+
+	beaconRoot := evmtypes.ParentBeaconRoot(ctx)
+	return &miner.BuildPayloadArgs{
+		Timestamp:    uint64(ctx.BlockTime().Unix()),
+		FeeRecipient: coinbase,
+		Random:       random,
+		Withdrawals:  withdrawals,
+		BeaconRoot:   &beaconRoot,
+	}, nil
 }
 
 // resolveEnvelope resolves the payload.
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/berachain/polaris/eth/core/state"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	// pendingRecommit is the interval at which the pending block is rebuilt, if new transactions
+	// arrived or the chain head changed since it was last built.
+	pendingRecommit = time.Second
+	// chainHeadChanSize is the size of the channel listening to ChainHeadEvent.
+	chainHeadChanSize = 10
+	// txChanSize is the size of the channel listening to NewTxsEvent.
+	txChanSize = 4096
+)
+
+// Start implements node.Lifecycle, it starts continuously rebuilding the pending block.
+func (m *Miner) Start() error {
+	go m.pendingLoop()
+	return nil
+}
+
+// Stop implements node.Lifecycle, it stops rebuilding the pending block.
+func (m *Miner) Stop() error {
+	close(m.stopCh)
+	return nil
+}
+
+// Pending returns the pending block, its receipts and the state after the pending block. It
+// returns nil if no pending block has been built yet.
+//
+// NOTE: the returned state is shared between all callers and is never written to by the miner
+// after it is returned, so it must be treated as read-only and copied before being modified.
+func (m *Miner) Pending() (*ethtypes.Block, ethtypes.Receipts, state.StateDB) {
+	m.pendingMu.RLock()
+	defer m.pendingMu.RUnlock()
+	if m.pendingBlock == nil {
+		return nil, nil, nil
+	}
+	return m.pendingBlock, m.pendingReceipts, m.pendingState
+}
+
+// pendingLoop rebuilds the pending block whenever new transactions arrive in the txpool or the
+// chain head changes. The rebuilds are batched per `pendingRecommit`, which also gives the host
+// chain the time to update the latest query context after a new head is written.
+func (m *Miner) pendingLoop() {
+	headCh := make(chan core.ChainHeadEvent, chainHeadChanSize)
+	headSub := m.bc.SubscribeChainHeadEvent(headCh)
+	defer headSub.Unsubscribe()
+
+	txsCh := make(chan core.NewTxsEvent, txChanSize)
+	txsSub := m.txPool.SubscribeTransactions(txsCh, true)
+	defer txsSub.Unsubscribe()
+
+	ticker := time.NewTicker(pendingRecommit)
+	defer ticker.Stop()
+
+	dirty := true
+	for {
+		select {
+		case <-headCh:
+			dirty = true
+		case <-txsCh:
+			dirty = true
+		case <-ticker.C:
+			if !dirty {
+				continue
+			}
+			if err := m.buildPending(); err != nil {
+				log.Error("failed to build pending block", "err", err)
+				continue
+			}
+			dirty = false
+		case <-headSub.Err():
+			return
+		case <-txsSub.Err():
+			return
+		case <-m.stopCh:
+			return
+		}
+	}
+}
+
+// buildPending builds the pending block on top of the current head from the pending
+// transactions in the txpool, which are ordered like the geth miner orders the transactions of
+// proposed blocks. The transactions are applied to a throwaway branch of the latest query
+// context, which is kept as the pending state.
+//
+// NOTE: the proposer of the next block is not known before it is proposed, so the coinbase of the
+// pending block is the EVM address of the proposer of the latest block. The fees paid to the
+// coinbase in the pending state are therefore only an estimate.
+func (m *Miner) buildPending() error {
+	head := m.bc.CurrentHeader()
+	if head == nil {
+		return errors.New("current header is nil")
+	}
+	statedb, err := m.bc.StateAtBlockNumber(head.Number.Uint64() + 1)
+	if err != nil {
+		return err
+	}
+	// The fee recipient is resolved from the latest query context, i.e. the latest proposer.
+	psdb, ok := statedb.(state.PolarStateDB)
+	if !ok {
+		return errors.New("pending state has no context")
+	}
+	coinbase, err := m.coinbase.Coinbase(sdk.UnwrapSDKContext(psdb.GetContext()))
+	if err != nil {
+		return err
+	}
+
+	var (
+		cfg    = m.bc.Config()
+		header = m.pendingHeader(head, coinbase)
+		gp     = new(core.GasPool).AddGas(header.GasLimit)
+
+		txs      ethtypes.Transactions
+		receipts ethtypes.Receipts
+	)
+	state.BindBlock(statedb, header.Number, header.Time)
+	ordered := miner.NewTransactionsByPriceAndNonce(
+		ethtypes.MakeSigner(cfg, header.Number, header.Time),
+		m.txPool.Pending(true), header.BaseFee,
+	)
+	for gp.Gas() >= params.TxGas {
+		ltx := ordered.Peek()
+		if ltx == nil {
+			break
+		}
+		tx := ltx.Resolve()
+		if tx == nil || ltx.Gas > gp.Gas() {
+			ordered.Pop()
+			continue
+		}
+		if header.BlobGasUsed != nil &&
+			*header.BlobGasUsed+tx.BlobGas() > params.MaxBlobGasPerBlock {
+			ordered.Pop()
+			continue
+		}
+
+		snap := statedb.Snapshot()
+		statedb.SetTxContext(tx.Hash(), len(txs))
+		receipt, err := core.ApplyTransaction(
+			cfg, m.bc, &header.Coinbase, gp, statedb, header, tx, &header.GasUsed,
+			*m.bc.GetVMConfig(),
+		)
+		if err != nil {
+			// The later transactions of the sender cannot be applied either.
+			statedb.RevertToSnapshot(snap)
+			ordered.Pop()
+			continue
+		}
+		if header.BlobGasUsed != nil {
+			*header.BlobGasUsed += tx.BlobGas()
+		}
+		txs = append(txs, tx)
+		receipts = append(receipts, receipt)
+		ordered.Shift()
+	}
+
+	// NOTE: the state root is not computed, since the pending block is never committed.
+	block := ethtypes.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+	m.pendingBlock, m.pendingReceipts, m.pendingState = block, receipts, statedb
+	return nil
+}
+
+// pendingHeader returns the header of the pending block with the given coinbase, on top of the
+// given parent.
+func (m *Miner) pendingHeader(parent *ethtypes.Header, coinbase common.Address) *ethtypes.Header {
+	timestamp := uint64(time.Now().Unix())
+	if timestamp <= parent.Time {
+		timestamp = parent.Time + 1
+	}
+	header := &ethtypes.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   coinbase,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       timestamp,
+		Difficulty: new(big.Int),
+	}
+	cfg := m.bc.Config()
+	if cfg.IsLondon(header.Number) {
+		header.BaseFee = eip1559.CalcBaseFee(cfg, parent)
+	}
+	if cfg.IsCancun(header.Number, header.Time) {
+		var parentExcessBlobGas, parentBlobGasUsed uint64
+		if cfg.IsCancun(parent.Number, parent.Time) && parent.ExcessBlobGas != nil {
+			parentExcessBlobGas, parentBlobGasUsed = *parent.ExcessBlobGas, *parent.BlobGasUsed
+		}
+		excessBlobGas := eip4844.CalcExcessBlobGas(parentExcessBlobGas, parentBlobGasUsed)
+		header.ExcessBlobGas, header.BlobGasUsed = &excessBlobGas, new(uint64)
+	}
+	return header
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/polaris/cosmos/runtime/comet"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// randomnessDomain separates the vote extensions of the randomness from any other signed data.
+var randomnessDomain = []byte("polaris/prevrandao")
+
+// ErrVoteExtensionsDisabled is returned for the blocks that cannot receive the vote extensions
+// that their random value is derived from.
+var ErrVoteExtensionsDisabled = errors.New("vote extensions are not enabled")
+
+// CometRandomness is the default RandomnessSource. Every validator contributes to the PREVRANDAO
+// value of a block with the signature of its vote extension on the previous block. The content of
+// the vote extension is fixed by the height, and the extensions are signed by CometBFT with the
+// (deterministic) ed25519 consensus key of the validator, so that each signature is unique and
+// cannot be predicted without the key of its validator. The proposer includes the extended commit
+// of the last block in the proposal, which every validator verifies against the last commit of
+// the block when processing the proposal.
+//
+// The value is unpredictable, but it is NOT unbiasable. The proposer chooses which of the votes
+// beyond 2/3 of the voting power make it into the last commit, so it can pick the value among
+// the subsets of those votes, and a validator can withhold its vote once it knows the others.
+// Contracts that need unbiasable randomness for valuable outcomes should use a commit-reveal
+// scheme or a VRF oracle on top of it.
+//
+// Vote extensions must be enabled from the initial height of the chain: the block at the enable
+// height, which has no vote extensions to receive, derives its value from the predictable
+// consensus data of the block, and any other block without vote extensions is rejected with
+// ErrVoteExtensionsDisabled.
+type CometRandomness struct {
+	valStore baseapp.ValidatorStore
+}
+
+// NewCometRandomness returns a RandomnessSource that verifies the vote extensions against the
+// consensus keys of the given validator store.
+func NewCometRandomness(valStore baseapp.ValidatorStore) *CometRandomness {
+	return &CometRandomness{valStore: valStore}
+}
+
+// Random implements RandomnessSource. It mixes the app hash committed to by the parent Comet
+// block, the height and proposer of the block, the votes of the last commit and the signatures of
+// the vote extensions of the extended commit, which are only left out at the enable height.
+func (r *CometRandomness) Random(
+	ctx sdk.Context, extCommit abci.ExtendedCommitInfo,
+) (common.Hash, error) {
+	header := ctx.BlockHeader()
+	data := make([]byte, 0, len(header.AppHash)+8+len(header.ProposerAddress))
+	data = append(data, header.AppHash...)
+	data = binary.BigEndian.AppendUint64(data, uint64(header.Height))
+	data = append(data, header.ProposerAddress...)
+
+	// Mix in the votes of the last commit, which are the same when preparing and when processing
+	// the proposal.
+	if info := ctx.CometInfo(); info != nil && info.GetLastCommit() != nil {
+		lastCommit := info.GetLastCommit()
+		data = binary.BigEndian.AppendUint32(data, uint32(lastCommit.Round()))
+		for i, votes := 0, lastCommit.Votes(); i < votes.Len(); i++ {
+			vote := votes.Get(i)
+			data = append(data, vote.Validator().Address()...)
+			data = binary.BigEndian.AppendUint32(data, uint32(vote.GetBlockIDFlag()))
+		}
+	}
+
+	if !comet.VoteExtensionsEnabled(ctx) {
+		if cp := ctx.ConsensusParams(); cp.Abci == nil ||
+			cp.Abci.VoteExtensionsEnableHeight != ctx.BlockHeight() {
+			return common.Hash{}, errorslib.Wrapf(
+				ErrVoteExtensionsDisabled, "Random: block %d", ctx.BlockHeight(),
+			)
+		}
+		return crypto.Keccak256Hash(data), nil
+	}
+
+	// Mix in the signatures of the vote extensions, once they are verified.
+	if err := baseapp.ValidateVoteExtensions(
+		ctx, r.valStore, ctx.BlockHeight(), ctx.ChainID(), extCommit,
+	); err != nil {
+		return common.Hash{}, errorslib.Wrap(err, "Random: invalid vote extensions")
+	}
+	extension := voteExtension(ctx.BlockHeight() - 1)
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		if !bytes.Equal(vote.VoteExtension, extension) {
+			return common.Hash{}, fmt.Errorf(
+				"Random: unexpected vote extension of validator %X", vote.Validator.Address,
+			)
+		}
+		data = append(data, vote.ExtensionSignature...)
+	}
+	return crypto.Keccak256Hash(data), nil
+}
+
+// ExtendVote implements sdk.ExtendVoteHandler by extending the vote of the validator with the
+// vote extension of the height, whose signature is its contribution to the randomness.
+func (r *CometRandomness) ExtendVote(
+	_ sdk.Context, req *abci.RequestExtendVote,
+) (*abci.ResponseExtendVote, error) {
+	return &abci.ResponseExtendVote{VoteExtension: voteExtension(req.Height)}, nil
+}
+
+// VerifyVoteExtension implements sdk.VerifyVoteExtensionHandler by only accepting the vote
+// extension of the height, so that validators cannot choose their contribution to the randomness.
+func (r *CometRandomness) VerifyVoteExtension(
+	_ sdk.Context, req *abci.RequestVerifyVoteExtension,
+) (*abci.ResponseVerifyVoteExtension, error) {
+	if !bytes.Equal(req.VoteExtension, voteExtension(req.Height)) {
+		return &abci.ResponseVerifyVoteExtension{
+			Status: abci.ResponseVerifyVoteExtension_REJECT,
+		}, nil
+	}
+	return &abci.ResponseVerifyVoteExtension{
+		Status: abci.ResponseVerifyVoteExtension_ACCEPT,
+	}, nil
+}
+
+// voteExtension returns the vote extension of the votes at the given height.
+func voteExtension(height int64) []byte {
+	return crypto.Keccak256(randomnessDomain, binary.BigEndian.AppendUint64(nil, uint64(height)))
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/berachain/polaris/cosmos/runtime/miner"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	protoio "github.com/cometbft/cometbft/libs/protoio"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const chainID = "polaris-2061"
+
+var _ = Describe("CometRandomness", func() {
+	var (
+		random     *miner.CometRandomness
+		valStore   mockValStore
+		validators []ed25519.PrivKey
+		ctx        sdk.Context
+	)
+
+	BeforeEach(func() {
+		valStore = make(mockValStore)
+		validators = nil
+		for i := 0; i < 4; i++ {
+			key := ed25519.GenPrivKey()
+			pk, err := cryptoenc.PubKeyToProto(key.PubKey())
+			Expect(err).ToNot(HaveOccurred())
+			valStore[string(key.PubKey().Address())] = pk
+			validators = append(validators, key)
+		}
+		random = miner.NewCometRandomness(valStore)
+		ctx = sdk.Context{}.WithChainID(chainID).WithBlockHeader(cmtproto.Header{
+			ChainID:         chainID,
+			Height:          10,
+			AppHash:         []byte{1, 2, 3},
+			ProposerAddress: validators[0].PubKey().Address(),
+		})
+	})
+
+	// extendedCommit returns the extended commit of the last block, in which the given validators
+	// voted for the block with the given vote extension.
+	extendedCommit := func(extension []byte, voters ...ed25519.PrivKey) abci.ExtendedCommitInfo {
+		extCommit := abci.ExtendedCommitInfo{}
+		for _, key := range validators {
+			vote := abci.ExtendedVoteInfo{
+				Validator:   abci.Validator{Address: key.PubKey().Address(), Power: 1},
+				BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+			}
+			for _, voter := range voters {
+				if voter.Equals(key) {
+					vote.BlockIdFlag = cmtproto.BlockIDFlagCommit
+					vote.VoteExtension = extension
+					vote.ExtensionSignature = signExtension(key, extension, ctx.BlockHeight()-1)
+				}
+			}
+			extCommit.Votes = append(extCommit.Votes, vote)
+		}
+		return extCommit
+	}
+
+	// voteExtension returns the vote extension of the validators at the given height.
+	voteExtension := func(height int64) []byte {
+		res, err := random.ExtendVote(ctx, &abci.RequestExtendVote{Height: height})
+		Expect(err).ToNot(HaveOccurred())
+		return res.VoteExtension
+	}
+
+	When("vote extensions are not enabled", func() {
+		It("should reject the block", func() {
+			_, err := random.Random(ctx, abci.ExtendedCommitInfo{})
+			Expect(err).To(MatchError(miner.ErrVoteExtensionsDisabled))
+
+			_, err = random.Random(ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 11},
+			}), abci.ExtendedCommitInfo{})
+			Expect(err).To(MatchError(miner.ErrVoteExtensionsDisabled))
+		})
+
+		It("should derive the value from the block at the enable height", func() {
+			ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 10},
+			})
+			value, err := random.Random(ctx, abci.ExtendedCommitInfo{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).ToNot(Equal(common.Hash{}))
+			Expect(random.Random(ctx, abci.ExtendedCommitInfo{})).To(Equal(value))
+
+			header := ctx.BlockHeader()
+			header.AppHash = []byte{4, 5, 6}
+			Expect(random.Random(ctx.WithBlockHeader(header), abci.ExtendedCommitInfo{})).
+				ToNot(Equal(value))
+		})
+	})
+
+	When("vote extensions are enabled", func() {
+		BeforeEach(func() {
+			ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+			})
+		})
+
+		It("should only accept the vote extension of the height", func() {
+			res, err := random.VerifyVoteExtension(ctx, &abci.RequestVerifyVoteExtension{
+				Height: 9, VoteExtension: voteExtension(9),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Status).To(Equal(abci.ResponseVerifyVoteExtension_ACCEPT))
+
+			res, err = random.VerifyVoteExtension(ctx, &abci.RequestVerifyVoteExtension{
+				Height: 9, VoteExtension: voteExtension(8),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Status).To(Equal(abci.ResponseVerifyVoteExtension_REJECT))
+		})
+
+		It("should derive the value from the signed vote extensions", func() {
+			extCommit := extendedCommit(voteExtension(9), validators...)
+			value, err := random.Random(ctx, extCommit)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(random.Random(ctx, extCommit)).To(Equal(value))
+			Expect(random.Random(ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 10},
+			}), abci.ExtendedCommitInfo{})).ToNot(Equal(value))
+
+			// The value depends on the signatures of the validators.
+			Expect(random.Random(ctx, extendedCommit(voteExtension(9), validators[1:]...))).
+				ToNot(Equal(value))
+		})
+
+		It("should reject vote extensions with insufficient voting power", func() {
+			_, err := random.Random(ctx, extendedCommit(voteExtension(9), validators[0]))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject forged vote extensions", func() {
+			extCommit := extendedCommit(voteExtension(9), validators...)
+			extCommit.Votes[0].ExtensionSignature = bytes.Repeat([]byte{1}, ed25519.SignatureSize)
+			_, err := random.Random(ctx, extCommit)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject vote extensions of another height", func() {
+			_, err := random.Random(ctx, extendedCommit(voteExtension(8), validators...))
+			Expect(err).To(MatchError(ContainSubstring("unexpected vote extension")))
+		})
+	})
+})
+
+// signExtension signs the vote extension at the given height the same way as CometBFT does.
+func signExtension(key ed25519.PrivKey, extension []byte, height int64) []byte {
+	var buf bytes.Buffer
+	_, err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		ChainId:   chainID,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	sig, err := key.Sign(buf.Bytes())
+	Expect(err).ToNot(HaveOccurred())
+	return sig
+}
+
+// mockValStore maps the consensus addresses of validators to their public keys.
+type mockValStore map[string]cmtprotocrypto.PublicKey
+
+func (m mockValStore) GetPubKeyByConsAddr(
+	_ context.Context, addr sdk.ConsAddress,
+) (cmtprotocrypto.PublicKey, error) {
+	if pk, ok := m[string(addr)]; ok {
+		return pk, nil
+	}
+	return cmtprotocrypto.PublicKey{}, errors.New("validator not found")
+}
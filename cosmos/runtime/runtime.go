@@ -50,6 +50,9 @@ import (
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
+
+	// To ensure that the cosmosTracer gets loaded in.
+	_ "github.com/berachain/polaris/cosmos/x/evm/plugins/precompile/tracer"
 )
 
 // EVMKeeper is an interface that defines the methods needed for the EVM setup.
@@ -64,6 +67,8 @@ type EVMKeeper interface {
 type CosmosApp interface {
 	SetPrepareProposal(sdk.PrepareProposalHandler)
 	SetProcessProposal(sdk.ProcessProposalHandler)
+	SetExtendVoteHandler(sdk.ExtendVoteHandler)
+	SetVerifyVoteExtensionHandler(sdk.VerifyVoteExtensionHandler)
 	SetMempool(mempool.Mempool)
 	SetAnteHandler(sdk.AnteHandler)
 	TxDecode(txBz []byte) (sdk.Tx, error)
@@ -135,20 +140,29 @@ func New(
 }
 
 // Build is a function that sets up the Polaris struct.
-// It takes a BaseApp and an EVMKeeper as arguments.
+// It takes a BaseApp, an EVMKeeper and a StakingKeeper as arguments.
 // It returns an error if the setup fails.
 func (p *Polaris) Build(
-	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper, allowedValMsgs map[string]sdk.Msg,
+	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper, sk comet.StakingKeeper,
+	allowedValMsgs map[string]sdk.Msg,
 ) error {
+	// The coinbase of every block is the EVM address of its Comet proposer, and its random value
+	// is derived from the vote extensions of the validators.
+	proposer := comet.NewProposerResolver(sk)
+	random := miner.NewCometRandomness(sk)
+
 	// Wrap the geth miner and txpool with the cosmos miner and txpool.
 	p.WrappedMiner = miner.New(
 		p.ExecutionLayer.Backend().Miner(), app, allowedValMsgs,
-		p.Backend().Blockchain(), &p.blockBuilderMu,
+		p.Backend().Blockchain(), p.Backend().TxPool(), proposer, random, &p.blockBuilderMu,
 	)
 	p.WrappedBlockchain = chain.New(
-		p.ExecutionLayer.Backend().Blockchain(), app,
+		p.ExecutionLayer.Backend().Blockchain(), app, proposer, random,
 	)
 
+	app.SetExtendVoteHandler(random.ExtendVote)
+	app.SetVerifyVoteExtensionHandler(random.VerifyVoteExtension)
+
 	p.ProposalProvider = polarabci.NewProposalProvider(
 		app.PreBlocker, app.BeginBlocker,
 		p.WrappedMiner, p.WrappedBlockchain,
@@ -183,11 +197,15 @@ func (p *Polaris) SetupServices(clientCtx client.Context) error {
 	// Register services with Polaris.
 	p.RegisterLifecycles([]node.Lifecycle{
 		p.WrappedTxPool,
+		p.WrappedMiner,
 	})
 
 	// Register the sync status provider with Polaris.
 	p.ExecutionLayer.Backend().RegisterSyncStatusProvider(comet.NewSyncProvider(clientCtx))
 
+	// Register the wrapped miner as the provider of the pending block with Polaris.
+	p.ExecutionLayer.Backend().RegisterPendingProvider(p.WrappedMiner)
+
 	// Start the services. TODO: move to place race condition is solved.
 	return p.StartServices()
 }
@@ -21,6 +21,8 @@
 package evm
 
 import (
+	"errors"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	store "cosmossdk.io/store/types"
@@ -28,10 +30,14 @@ import (
 	modulev1alpha1 "github.com/berachain/polaris/cosmos/api/polaris/evm/module/v1alpha1"
 	"github.com/berachain/polaris/cosmos/config"
 	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 //nolint:gochecknoinits // GRRRR fix later.
@@ -53,8 +59,11 @@ type DepInjectInput struct {
 	PolarisCfg        func() *config.Config
 	CustomPrecompiles func() *ethprecompile.Injector `optional:"true"`
 	QueryContextFn    func() func(height int64, prove bool) (sdk.Context, error)
+	StoreQueryFn      func() state.StoreQueryFn `optional:"true"`
+	BankBalances      *state.BankBalancesConfig `optional:"true"`
 
 	AccountKeeper AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper `optional:"true"`
 }
 
 // DepInjectOutput is the output for the dep inject framework.
@@ -66,7 +75,7 @@ type DepInjectOutput struct {
 }
 
 // ProvideModule is a function that provides the module to the application.
-func ProvideModule(in DepInjectInput) DepInjectOutput {
+func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	// Default to empty precompile injector if not provided.
 	if in.CustomPrecompiles == nil {
 		in.CustomPrecompiles = func() *ethprecompile.Injector { return &ethprecompile.Injector{} }
@@ -75,14 +84,32 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 	k := keeper.NewKeeper(
 		in.AccountKeeper,
 		in.Key,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		in.CustomPrecompiles,
 		in.QueryContextFn,
 		in.PolarisCfg(),
 	)
+	// State proofs are only served if a store query function is provided.
+	if in.StoreQueryFn != nil {
+		k.SetStoreQueryFn(in.StoreQueryFn)
+	}
+	// The native balances are kept in x/bank if a bank denom is provided.
+	if in.BankBalances != nil {
+		if in.BankKeeper.Balances == nil {
+			return DepInjectOutput{}, errors.New("bank balances require a bank keeper")
+		}
+		balances, err := state.NewBankBalances(
+			in.BankKeeper, in.BankKeeper.Balances.Indexes.Denom, in.Key, *in.BankBalances,
+		)
+		if err != nil {
+			return DepInjectOutput{}, err
+		}
+		k.SetBalances(balances)
+	}
 	m := NewAppModule(k, in.AccountKeeper)
 
 	return DepInjectOutput{
 		Keeper: k,
 		Module: m,
-	}
+	}, nil
 }
@@ -22,7 +22,9 @@ package evm
 
 import (
 	"encoding/json"
+	"errors"
 
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -30,6 +32,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultGenesis returns default genesis state as raw bytes for the evm
@@ -49,14 +54,50 @@ func (AppModuleBasic) ValidateGenesis(
 	_ client.TxEncodingConfig,
 	bz json.RawMessage,
 ) error {
-	ethGen := new(core.Genesis)
-	if err := ethGen.UnmarshalJSON(bz); err != nil {
+	gs := new(types.GenesisState)
+	if err := gs.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	return errors.Join(types.ValidateGenesis(gs.Genesis, nil), gs.Precompiles.Validate())
+}
+
+// ValidateGenesis performs genesis state validation for the evm module, which also rejects the
+// allocs to the registered precompiles of the keeper.
+func (am AppModule) ValidateGenesis(
+	_ codec.JSONCodec,
+	_ client.TxEncodingConfig,
+	bz json.RawMessage,
+) error {
+	gs := new(types.GenesisState)
+	if err := gs.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	return errors.Join(am.keeper.ValidateGenesis(gs.Genesis), gs.Precompiles.Validate())
+}
+
+// ValidateGenesisWithAuth validates the evm genesis state of the given app state against its auth
+// genesis state, whose account sequences must match the nonces of the evm allocs.
+func ValidateGenesisWithAuth(cdc codec.Codec, appState map[string]json.RawMessage) error {
+	gs := new(types.GenesisState)
+	if err := gs.UnmarshalJSON(appState[types.ModuleName]); err != nil {
 		return err
 	}
 
-	// TODO: figure out what in geth we need to call in order to validate the genesis.
+	accounts, err := authtypes.UnpackAccounts(
+		authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts,
+	)
+	if err != nil {
+		return err
+	}
+	sequences := make(map[common.Address]uint64, len(accounts))
+	for _, account := range accounts {
+		sequences[common.BytesToAddress(account.GetAddress())] = account.GetSequence()
+	}
 
-	return nil
+	return errors.Join(
+		types.ValidateGenesis(gs.Genesis, nil), gs.Precompiles.Validate(),
+		types.ValidateGenesisNonces(gs.Genesis, sequences),
+	)
 }
 
 // InitGenesis performs genesis initialization for the evm module. It returns
@@ -66,12 +107,15 @@ func (am AppModule) InitGenesis(
 	_ codec.JSONCodec,
 	data json.RawMessage,
 ) []abci.ValidatorUpdate {
-	var ethGen core.Genesis
-	if err := ethGen.UnmarshalJSON(data); err != nil {
+	var gs types.GenesisState
+	if err := gs.UnmarshalJSON(data); err != nil {
 		panic(err)
 	}
 
-	if err := am.keeper.InitGenesis(ctx, &ethGen); err != nil {
+	if err := am.keeper.InitGenesis(ctx, gs.Genesis); err != nil {
+		panic(err)
+	}
+	if err := am.keeper.InitPrecompileGenesis(ctx, gs.Precompiles); err != nil {
 		panic(err)
 	}
 	return []abci.ValidatorUpdate{}
@@ -80,10 +124,14 @@ func (am AppModule) InitGenesis(
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
-	ethGen := am.keeper.ExportGenesis(ctx)
-	ethGenBz, err := ethGen.MarshalJSON()
+	pg, err := am.keeper.ExportPrecompileGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs := types.GenesisState{Genesis: am.keeper.ExportGenesis(ctx), Precompiles: pg}
+	bz, err := gs.MarshalJSON()
 	if err != nil {
 		panic(err)
 	}
-	return ethGenBz
+	return bz
 }
@@ -33,12 +33,16 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm"
 	"github.com/berachain/polaris/cosmos/x/evm/keeper"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
@@ -74,6 +78,7 @@ var _ = Describe("Genesis", func() {
 		k = keeper.NewKeeper(
 			ak,
 			testutil.EvmKey,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{}...)
 			},
@@ -85,7 +90,9 @@ var _ = Describe("Genesis", func() {
 			cfg,
 		)
 		err = k.Setup(
-			chain.New(core.NewChain(k.Host, params.DefaultChainConfig, beacon.NewFaker()), nil),
+			chain.New(
+				core.NewChain(k.Host, params.DefaultChainConfig, beacon.NewFaker()), nil, nil, nil,
+			),
 			nil,
 		)
 		Expect(err).ToNot(HaveOccurred())
@@ -143,6 +150,96 @@ var _ = Describe("Genesis", func() {
 		})
 	})
 
+	Describe("On ValidateGenesis", func() {
+		var gen core.Genesis
+		BeforeEach(func() {
+			gen = *core.DefaultGenesis
+			gen.Config = params.DefaultChainConfig
+			gen.Alloc = core.GenesisAlloc{}
+			for addr, acc := range core.DefaultGenesis.Alloc {
+				gen.Alloc[addr] = acc
+			}
+		})
+		validate := func() error {
+			bz, err := json.Marshal(&gen)
+			Expect(err).ToNot(HaveOccurred())
+			return am.ValidateGenesis(nil, nil, bz)
+		}
+
+		It("should accept the default genesis", func() {
+			Expect(validate()).To(Succeed())
+			bz, err := json.Marshal(core.DefaultGenesis)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(evm.AppModuleBasic{}.ValidateGenesis(nil, nil, bz)).To(Succeed())
+		})
+
+		It("should report every problem", func() {
+			config := *params.DefaultChainConfig
+			config.BerlinBlock = big.NewInt(10)
+			gen.Config = &config
+			gen.GasLimit = 1
+			gen.BaseFee = big.NewInt(0)
+			gen.Alloc[common.BytesToAddress([]byte{0x1})] = core.GenesisAccount{
+				Balance: big.NewInt(1),
+			}
+			gen.Alloc[common.Address{0x2}] = core.GenesisAccount{
+				Balance: big.NewInt(0),
+				Code:    make([]byte, ethparams.MaxCodeSize+1),
+			}
+			gen.Alloc[common.Address{0x3}] = core.GenesisAccount{
+				Balance: big.NewInt(0),
+				Storage: map[common.Hash]common.Hash{{0x1}: {0x1}},
+			}
+
+			err = validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("chain config: unsupported fork ordering"))
+			Expect(err.Error()).To(ContainSubstring("gas limit 1 is below the minimum of 5000"))
+			Expect(err.Error()).To(ContainSubstring("base fee 0 must be positive"))
+			Expect(err.Error()).To(ContainSubstring(
+				"alloc 0x0000000000000000000000000000000000000001: address is a precompile"))
+			Expect(err.Error()).To(ContainSubstring(
+				"alloc 0x0200000000000000000000000000000000000000: code size 40001 is above"))
+			Expect(err.Error()).To(ContainSubstring(
+				"alloc 0x0300000000000000000000000000000000000000: storage is set without code"))
+		})
+
+		It("should validate the precompile state", func() {
+			pg := &evmtypes.PrecompileGenesis{
+				Dynamic: []evmtypes.DynamicPrecompile{
+					{Address: common.Address{0x9}, Kind: "mock", Args: "abera"},
+					{Address: common.Address{0x9}},
+				},
+				MethodBaseGas: []evmtypes.MethodBaseGas{{Precompile: common.Address{0x9}}},
+			}
+			bz, err := evmtypes.GenesisState{Genesis: &gen, Precompiles: pg}.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			gs := new(evmtypes.GenesisState)
+			Expect(gs.UnmarshalJSON(bz)).To(Succeed())
+			Expect(gs.Precompiles).To(Equal(pg))
+
+			err = am.ValidateGenesis(nil, nil, bz)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"dynamic precompile 0x0900000000000000000000000000000000000000: duplicate"))
+			Expect(err.Error()).To(ContainSubstring(
+				"dynamic precompile 0x0900000000000000000000000000000000000000: empty kind"))
+			Expect(err.Error()).To(ContainSubstring(
+				"base gas 0x0900000000000000000000000000000000000000: empty method"))
+		})
+
+		It("should check the nonces against the auth genesis", func() {
+			addr := common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4")
+			Expect(evmtypes.ValidateGenesisNonces(&gen, map[common.Address]uint64{
+				addr: 0,
+			})).To(Succeed())
+			Expect(evmtypes.ValidateGenesisNonces(&gen, map[common.Address]uint64{
+				addr: 2,
+			})).To(MatchError(ContainSubstring(
+				"nonce 0 does not match the auth genesis sequence 2")))
+		})
+	})
+
 	Describe("On ExportGenesis", func() {
 		var (
 			actualGenesis core.Genesis
@@ -24,6 +24,8 @@ import (
 	"context"
 	"fmt"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -42,6 +44,13 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 		)
 	}
 
+	// The app only sets the header of the block to be committed on a root multistore, so that
+	// it is set here when the commit multistore tracks the accounts of the EVM.
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if ms, ok := sCtx.MultiStore().(interface{ SetCommitHeader(cmtproto.Header) }); ok {
+		ms.SetCommitHeader(sCtx.BlockHeader())
+	}
+
 	// Set the finalized eth block once we know it has been finalized successfully by Cosmos.
 	return k.chain.SetFinalizedBlock()
 }
@@ -22,16 +22,22 @@ package keeper
 
 import (
 	"github.com/berachain/polaris/cosmos/x/evm/plugins"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/lib/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // InitGenesis is called during the InitGenesis.
 func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
 	// TODO: Feels jank as fuck lol, but it works.
 	genState.Config = k.chain.Config()
+	if err := k.ValidateGenesis(genState); err != nil {
+		return err
+	}
 
 	// Initialize all the plugins.
 	for _, plugin := range k.Host.GetAllPlugins() {
@@ -59,3 +65,23 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
 	}
 	return genesisState
 }
+
+// ValidateGenesis validates the given genesis state, which may not allocate to the addresses of
+// the registered precompiles.
+func (k *Keeper) ValidateGenesis(genState *core.Genesis) error {
+	return types.ValidateGenesis(genState, k.pp.GetActive(params.Rules{}))
+}
+
+// InitPrecompileGenesis is called during the InitGenesis with the precompile state of the
+// genesis.
+func (k *Keeper) InitPrecompileGenesis(ctx sdk.Context, pg *types.PrecompileGenesis) error {
+	if err := pg.Validate(); err != nil {
+		return err
+	}
+	return k.pp.InitPrecompileGenesis(ctx, pg)
+}
+
+// ExportPrecompileGenesis returns the exported precompile state.
+func (k *Keeper) ExportPrecompileGenesis(ctx sdk.Context) (*types.PrecompileGenesis, error) {
+	return k.pp.ExportPrecompileGenesis(ctx)
+}
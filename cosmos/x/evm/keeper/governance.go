@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// UpdatePrecompileGas implements the MsgServer interface. It sets the base gas of a method of a
+// stateful precompile, which is charged before the method is run.
+func (k *Keeper) UpdatePrecompileGas(
+	ctx context.Context, msg *evmtypes.MsgUpdatePrecompileGas,
+) (*evmtypes.MsgUpdatePrecompileGasResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := checkPrecompileAddress(msg.Precompile); err != nil {
+		return nil, err
+	}
+
+	if err := k.pp.SetMethodBaseGas(
+		ctx, common.HexToAddress(msg.Precompile), msg.Method, msg.BaseGas,
+	); err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgUpdatePrecompileGasResponse{}, nil
+}
+
+// CreatePrecompile implements the MsgServer interface. It instantiates a dynamic precompile of a
+// registered kind at the address derived from the kind and the arguments.
+func (k *Keeper) CreatePrecompile(
+	ctx context.Context, msg *evmtypes.MsgCreatePrecompile,
+) (*evmtypes.MsgCreatePrecompileResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.pp.InstantiatePrecompile(ctx, msg.Kind, msg.Args)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgCreatePrecompileResponse{Address: addr.Hex()}, nil
+}
+
+// SetPrecompilePaused implements the MsgServer interface. It pauses or resumes a dynamic
+// precompile.
+func (k *Keeper) SetPrecompilePaused(
+	ctx context.Context, msg *evmtypes.MsgSetPrecompilePaused,
+) (*evmtypes.MsgSetPrecompilePausedResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := checkPrecompileAddress(msg.Precompile); err != nil {
+		return nil, err
+	}
+
+	if err := k.pp.SetPrecompilePaused(
+		ctx, common.HexToAddress(msg.Precompile), msg.Paused,
+	); err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgSetPrecompilePausedResponse{}, nil
+}
+
+// RemovePrecompile implements the MsgServer interface. It removes a dynamic precompile.
+func (k *Keeper) RemovePrecompile(
+	ctx context.Context, msg *evmtypes.MsgRemovePrecompile,
+) (*evmtypes.MsgRemovePrecompileResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := checkPrecompileAddress(msg.Precompile); err != nil {
+		return nil, err
+	}
+
+	if err := k.pp.RemovePrecompile(ctx, common.HexToAddress(msg.Precompile)); err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgRemovePrecompileResponse{}, nil
+}
+
+// checkAuthority returns an error if the given address is not the authority of the governance
+// messages.
+func (k *Keeper) checkAuthority(authority string) error {
+	if authority != k.authority {
+		return errorslib.Wrapf(
+			govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, authority,
+		)
+	}
+	return nil
+}
+
+// checkPrecompileAddress returns an error if the given precompile address is not a hex address.
+func checkPrecompileAddress(precompile string) error {
+	if !common.IsHexAddress(precompile) {
+		return errorslib.Wrapf(
+			sdkerrors.ErrInvalidAddress, "invalid precompile address %s", precompile,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+	bankprecompile "github.com/berachain/polaris/cosmos/precompile/bank"
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/precompile"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/lib/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UpdatePrecompileGas", func() {
+	var (
+		ctx       sdk.Context
+		k         *keeper.Keeper
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		k = keeper.NewKeeper(
+			ak, testutil.EvmKey, authority,
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+			func() func(height int64, prove bool) (sdk.Context, error) {
+				return func(int64, bool) (sdk.Context, error) { return ctx, nil }
+			},
+			config.DefaultPolarisConfig(),
+		)
+		Expect(k.SetupPrecompiles()).To(Succeed())
+	})
+
+	It("should only accept the authority", func() {
+		_, err := k.UpdatePrecompileGas(ctx, &evmtypes.MsgUpdatePrecompileGas{
+			Authority:  testutil.Alice.String(),
+			Precompile: "0x0000000000000000000000000000000000000069",
+			Method:     "delegate",
+			BaseGas:    1000,
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+	})
+
+	It("should reject invalid and unknown precompiles", func() {
+		_, err := k.UpdatePrecompileGas(ctx, &evmtypes.MsgUpdatePrecompileGas{
+			Authority:  authority,
+			Precompile: "staking",
+			Method:     "delegate",
+			BaseGas:    1000,
+		})
+		Expect(err).To(MatchError(sdkerrors.ErrInvalidAddress))
+
+		_, err = k.UpdatePrecompileGas(ctx, &evmtypes.MsgUpdatePrecompileGas{
+			Authority:  authority,
+			Precompile: "0x0000000000000000000000000000000000000069",
+			Method:     "delegate",
+			BaseGas:    1000,
+		})
+		Expect(err).To(MatchError(precompile.ErrPrecompileNotFound))
+	})
+})
+
+var _ = Describe("Dynamic precompiles", func() {
+	var (
+		ctx       sdk.Context
+		k         *keeper.Keeper
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		var bk bankkeeper.BaseKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		k = keeper.NewKeeper(
+			ak, testutil.EvmKey, authority,
+			func() *ethprecompile.Injector {
+				pcs := ethprecompile.NewPrecompiles()
+				pcs.AddDynamicKind(bankprecompile.DenomKind, bankprecompile.NewDenomConstructor(
+					ak, bankkeeper.NewMsgServerImpl(bk), bk,
+				))
+				return pcs
+			},
+			func() func(height int64, prove bool) (sdk.Context, error) {
+				return func(int64, bool) (sdk.Context, error) { return ctx, nil }
+			},
+			config.DefaultPolarisConfig(),
+		)
+		Expect(k.SetupPrecompiles()).To(Succeed())
+	})
+
+	It("should only accept the authority", func() {
+		_, err := k.CreatePrecompile(ctx, &evmtypes.MsgCreatePrecompile{
+			Authority: testutil.Alice.String(),
+			Kind:      bankprecompile.DenomKind,
+			Args:      "abera",
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+
+		_, err = k.SetPrecompilePaused(ctx, &evmtypes.MsgSetPrecompilePaused{
+			Authority:  testutil.Alice.String(),
+			Precompile: "0x0000000000000000000000000000000000000069",
+			Paused:     true,
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+
+		_, err = k.RemovePrecompile(ctx, &evmtypes.MsgRemovePrecompile{
+			Authority:  testutil.Alice.String(),
+			Precompile: "0x0000000000000000000000000000000000000069",
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+	})
+
+	It("should create, pause and remove a bank denom precompile", func() {
+		res, err := k.CreatePrecompile(ctx, &evmtypes.MsgCreatePrecompile{
+			Authority: authority,
+			Kind:      bankprecompile.DenomKind,
+			Args:      "abera",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		pcAddr := precompile.DynamicPrecompileAddress(bankprecompile.DenomKind, "abera")
+		Expect(res.Address).To(Equal(pcAddr.Hex()))
+
+		pp := utils.MustGetAs[ethprecompile.ContextualPlugin](k.GetPrecompilePlugin())
+		_, found := pp.WithContext(ctx).Get(pcAddr, nil)
+		Expect(found).To(BeTrue())
+
+		_, err = k.SetPrecompilePaused(ctx, &evmtypes.MsgSetPrecompilePaused{
+			Authority:  authority,
+			Precompile: pcAddr.Hex(),
+			Paused:     true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = k.RemovePrecompile(ctx, &evmtypes.MsgRemovePrecompile{
+			Authority:  authority,
+			Precompile: pcAddr.Hex(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, found = pp.WithContext(ctx).Get(pcAddr, nil)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should reject invalid precompiles", func() {
+		_, err := k.CreatePrecompile(ctx, &evmtypes.MsgCreatePrecompile{
+			Authority: authority,
+			Kind:      bankprecompile.DenomKind,
+			Args:      "_invalid_denom",
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = k.CreatePrecompile(ctx, &evmtypes.MsgCreatePrecompile{
+			Authority: authority,
+			Kind:      "unknown",
+			Args:      "abera",
+		})
+		Expect(err).To(MatchError(precompile.ErrUnknownDynamicKind))
+
+		_, err = k.SetPrecompilePaused(ctx, &evmtypes.MsgSetPrecompilePaused{
+			Authority:  authority,
+			Precompile: "bank",
+			Paused:     true,
+		})
+		Expect(err).To(MatchError(sdkerrors.ErrInvalidAddress))
+
+		_, err = k.RemovePrecompile(ctx, &evmtypes.MsgRemovePrecompile{
+			Authority:  authority,
+			Precompile: "0x0000000000000000000000000000000000000069",
+		})
+		Expect(err).To(MatchError(precompile.ErrPrecompileNotFound))
+	})
+})
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/precompile"
 	pclog "github.com/berachain/polaris/cosmos/x/evm/plugins/precompile/log"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/withdrawals"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
@@ -45,6 +46,7 @@ type Host struct {
 	hp  historical.Plugin
 	pp  precompile.Plugin
 	sp  state.Plugin
+	wp  withdrawals.Plugin
 	spf *state.SPFactory
 
 	pcs func() *ethprecompile.Injector
@@ -64,8 +66,9 @@ func NewHost(
 			storeKey, qc,
 		),
 		pcs: precompiles,
-		pp:  precompile.NewPlugin(),
+		pp:  precompile.NewPlugin(storeKey, cfg.Polar.Precompiles),
 		sp:  state.NewPlugin(ak, storeKey, qc, nil),
+		wp:  withdrawals.NewPlugin(storeKey),
 	}
 
 	// historical plugin requires block plugin.
@@ -77,17 +80,36 @@ func NewHost(
 // SetupPrecompiles initializes the precompile contracts.
 func (h *Host) SetupPrecompiles() error {
 	// Set the query context function for the block and state plugins
-	pcs := h.pcs().GetPrecompiles()
+	injector := h.pcs()
+	pcs := injector.GetPrecompiles()
 
 	if err := h.pp.RegisterPrecompiles(pcs); err != nil {
 		return err
 	}
+	for kind, constructor := range injector.GetDynamicKinds() {
+		h.pp.RegisterDynamicKind(kind, constructor)
+	}
 
-	h.sp.SetPrecompileLogFactory(pclog.NewFactory(pcs))
-	h.spf.SetPrecompileLogFactory(pclog.NewFactory(pcs))
+	// the log factory is shared, as it learns the events of the dynamic precompiles at runtime
+	plf := pclog.NewFactory(pcs)
+	h.pp.SetPrecompileLogFactory(plf)
+	h.sp.SetPrecompileLogFactory(plf)
+	h.spf.SetPrecompileLogFactory(plf)
 	return nil
 }
 
+// SetStoreQueryFn sets the function used by the state plugins to query proofs of the evm store.
+func (h *Host) SetStoreQueryFn(sqf func() state.StoreQueryFn) {
+	h.sp.SetStoreQueryFn(sqf)
+	h.spf.SetStoreQueryFn(sqf)
+}
+
+// SetBalances sets the backend that holds the native balances of the EVM accounts.
+func (h *Host) SetBalances(balances state.Balances) {
+	h.sp.SetBalances(balances)
+	h.spf.SetBalances(balances)
+}
+
 // GetBlockPlugin returns the header plugin.
 func (h *Host) GetBlockPlugin() core.BlockPlugin {
 	return h.bp
@@ -103,13 +125,18 @@ func (h *Host) GetPrecompilePlugin() core.PrecompilePlugin {
 	return h.pp
 }
 
+// GetWithdrawalsPlugin returns the withdrawals plugin.
+func (h *Host) GetWithdrawalsPlugin() core.WithdrawalsPlugin {
+	return h.wp
+}
+
 func (h *Host) GetStatePluginFactory() core.StatePluginFactory {
 	return h.spf
 }
 
 // GetAllPlugins returns all the plugins.
 func (h *Host) GetAllPlugins() []any {
-	return []any{h.bp, h.hp, h.pp, h.sp}
+	return []any{h.bp, h.hp, h.pp, h.sp, h.wp}
 }
 
 // Version returns the version of the host chain.
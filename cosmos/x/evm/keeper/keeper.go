@@ -21,6 +21,8 @@
 package keeper
 
 import (
+	"context"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -32,6 +34,9 @@ import (
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 type Keeper struct {
@@ -41,12 +46,16 @@ type Keeper struct {
 	// provider is the struct that houses the Polaris EVM.
 	chain  core.Blockchain
 	txpool *txpool.Mempool
+
+	// authority is the address allowed to execute the governance messages (defaults to x/gov).
+	authority string
 }
 
 // NewKeeper creates new instances of the polaris Keeper.
 func NewKeeper(
 	ak state.AccountKeeper,
 	storeKey storetypes.StoreKey,
+	authority string,
 	pcs func() *ethprecompile.Injector,
 	qc func() func(height int64, prove bool) (sdk.Context, error),
 	polarisCfg *config.Config,
@@ -59,7 +68,8 @@ func NewKeeper(
 		qc,
 	)
 	return &Keeper{
-		Host: host,
+		Host:      host,
+		authority: authority,
 	}
 }
 
@@ -73,7 +83,21 @@ func (k *Keeper) GetHost() core.PolarisHostChain {
 	return k.Host
 }
 
+// GetAuthority returns the address allowed to execute the governance messages.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(types.ModuleName)
 }
+
+// QueueWithdrawal queues a native credit of the given amount, in gwei, to the given EVM address,
+// such as a matured unbonding or a bridge release. The credit is included in the next EVM blocks
+// as an EIP-4895 withdrawal, so the caller must escrow or burn the credited funds on its side.
+func (k *Keeper) QueueWithdrawal(
+	ctx context.Context, validator uint64, address common.Address, amount uint64,
+) (*ethtypes.Withdrawal, error) {
+	return k.wp.QueueWithdrawal(ctx, validator, address, amount)
+}
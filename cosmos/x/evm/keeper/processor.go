@@ -60,7 +60,9 @@ func (k *Keeper) ProcessPayloadEnvelope(
 		return nil, fmt.Errorf("failed to unmarshal payload envelope: %w", err)
 	}
 
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
+	if block, err = evmtypes.ExecutableDataToBlock(
+		sCtx, k.chain.Config(), &envelope,
+	); err != nil {
 		k.Logger(sCtx).Error("failed to build evm block", "err", err)
 		return nil, err
 	}
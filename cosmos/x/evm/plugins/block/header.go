@@ -32,6 +32,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // prevHeaderHashes is the number of previous header hashes being stored on chain.
@@ -76,8 +77,24 @@ func (p *plugin) GetHeaderByHash(hash common.Hash) (*ethtypes.Header, error) {
 	return p.GetHeaderByNumber(new(big.Int).SetBytes(numBz).Uint64())
 }
 
+// GetHeaderByStateRoot returns the header of the latest block with the given state root. Unlike
+// header hashes, state roots are not pruned.
+//
+// GetHeaderByStateRoot implements core.BlockPlugin.
+func (p *plugin) GetHeaderByStateRoot(root common.Hash) (*ethtypes.Header, error) {
+	numBz := p.ctx.MultiStore().GetKVStore(p.storekey).Get(stateRootKeyFor(root))
+	if numBz == nil {
+		return nil, core.ErrHeaderNotFound
+	}
+	return p.GetHeaderByNumber(new(big.Int).SetBytes(numBz).Uint64())
+}
+
 // StoreHeader implements core.BlockPlugin.
 func (p *plugin) StoreHeader(header *ethtypes.Header) error {
+	if err := validateCancunFields(header); err != nil {
+		return errorslib.Wrap(err, "StoreHeader: invalid header")
+	}
+
 	headerHash := header.Hash()
 	headerBz, err := coretypes.MarshalHeader(header)
 	if err != nil {
@@ -94,7 +111,7 @@ func (p *plugin) StoreHeader(header *ethtypes.Header) error {
 
 	// write genesis header
 	if blockHeight == 0 {
-		return p.writeGenesisHeaderBytes(headerHash, headerBz)
+		return p.writeGenesisHeaderBytes(headerHash, header.Root, headerBz)
 	}
 
 	kvstore := p.ctx.MultiStore().GetKVStore(p.storekey)
@@ -110,7 +127,36 @@ func (p *plugin) StoreHeader(header *ethtypes.Header) error {
 	}
 	kvstore.Set(headerHashKeyForHeight(blockHeight), headerHash.Bytes())
 	kvstore.Set(headerHash.Bytes(), header.Number.Bytes())
+	kvstore.Set(stateRootKeyFor(header.Root), header.Number.Bytes())
+
+	return nil
+}
+
+// validateCancunFields checks that the EIP-4844 blob gas fields and the EIP-4788 parent beacon
+// root are either all set (Cancun headers) or all unset, and that the blob gas used is valid.
+func validateCancunFields(header *ethtypes.Header) error {
+	isCancun := header.ExcessBlobGas != nil
+	if (header.BlobGasUsed != nil) != isCancun || (header.ParentBeaconRoot != nil) != isCancun {
+		return fmt.Errorf(
+			"partial cancun fields, excessBlobGas set: %t, blobGasUsed set: %t, "+
+				"parentBeaconRoot set: %t", isCancun, header.BlobGasUsed != nil,
+			header.ParentBeaconRoot != nil,
+		)
+	}
+	if !isCancun {
+		return nil
+	}
 
+	if blobGasUsed := *header.BlobGasUsed; blobGasUsed > params.MaxBlobGasPerBlock {
+		return fmt.Errorf(
+			"blob gas used %d exceeds maximum %d", blobGasUsed, params.MaxBlobGasPerBlock,
+		)
+	} else if blobGasUsed%params.BlobTxBlobGasPerBlob != 0 {
+		return fmt.Errorf(
+			"blob gas used %d not a multiple of blob gas per blob %d",
+			blobGasUsed, params.BlobTxBlobGasPerBlob,
+		)
+	}
 	return nil
 }
 
@@ -148,9 +194,13 @@ func (p *plugin) readHeaderBytes(number uint64) ([]byte, error) {
 //
 //	GenesisHeaderKey --> Header bytes
 //	Header Hash      --> 0
-func (p *plugin) writeGenesisHeaderBytes(headerHash common.Hash, headerBz []byte) error {
+//	State Root       --> 0
+func (p *plugin) writeGenesisHeaderBytes(
+	headerHash, stateRoot common.Hash, headerBz []byte,
+) error {
 	p.ctx.MultiStore().GetKVStore(p.storekey).Set([]byte{types.GenesisHeaderKey}, headerBz)
 	p.ctx.MultiStore().GetKVStore(p.storekey).Set(headerHash.Bytes(), new(big.Int).Bytes())
+	p.ctx.MultiStore().GetKVStore(p.storekey).Set(stateRootKeyFor(stateRoot), new(big.Int).Bytes())
 	return nil
 }
 
@@ -37,6 +37,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -99,6 +100,73 @@ var _ = Describe("Header", func() {
 		Expect(header3.Hash()).To(Equal(header.Hash()))
 	})
 
+	It("should store cancun headers", func() {
+		p.Prepare(ctx.WithBlockHeight(10))
+
+		header := generateHeaderAtHeight(10)
+		excessBlobGas, blobGasUsed := uint64(params.BlobTxTargetBlobGasPerBlock), uint64(0)
+		header.BaseFee = big.NewInt(params.InitialBaseFee)
+		header.WithdrawalsHash = &ethtypes.EmptyWithdrawalsHash
+		header.ExcessBlobGas, header.BlobGasUsed = &excessBlobGas, &blobGasUsed
+		header.ParentBeaconRoot = &common.Hash{0x09}
+		Expect(p.StoreHeader(header)).ToNot(HaveOccurred())
+
+		header2, err := types.UnmarshalHeader(
+			ctx.MultiStore().GetKVStore(testutil.EvmKey).Get([]byte{evmtypes.HeaderKey}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header2.Hash()).To(Equal(header.Hash()))
+		Expect(*header2.ExcessBlobGas).To(Equal(excessBlobGas))
+		Expect(*header2.BlobGasUsed).To(Equal(blobGasUsed))
+		Expect(*header2.ParentBeaconRoot).To(Equal(common.Hash{0x09}))
+	})
+
+	It("should reject invalid cancun headers", func() {
+		p.Prepare(ctx.WithBlockHeight(10))
+
+		// partial cancun fields
+		header := generateHeaderAtHeight(10)
+		blobGasUsed := uint64(params.BlobTxBlobGasPerBlob)
+		header.BlobGasUsed = &blobGasUsed
+		Expect(p.StoreHeader(header)).To(HaveOccurred())
+
+		// blob gas used above the maximum
+		excessBlobGas := uint64(0)
+		header.ExcessBlobGas, header.ParentBeaconRoot = &excessBlobGas, &common.Hash{}
+		blobGasUsed = params.MaxBlobGasPerBlock + params.BlobTxBlobGasPerBlob
+		Expect(p.StoreHeader(header)).To(HaveOccurred())
+
+		// blob gas used not a multiple of the blob gas per blob
+		blobGasUsed = params.BlobTxBlobGasPerBlob - 1
+		Expect(p.StoreHeader(header)).To(HaveOccurred())
+
+		blobGasUsed = params.MaxBlobGasPerBlock
+		Expect(p.StoreHeader(header)).ToNot(HaveOccurred())
+	})
+
+	It("should get headers by state root", func() {
+		genesis := &ethtypes.Header{
+			Number: big.NewInt(0),
+			Root:   common.Hash{0x07},
+		}
+		Expect(p.StoreHeader(genesis)).ToNot(HaveOccurred())
+
+		p.Prepare(ctx.WithBlockHeight(10))
+		header := generateHeaderAtHeight(10)
+		Expect(p.StoreHeader(header)).ToNot(HaveOccurred())
+
+		header2, err := p.GetHeaderByStateRoot(genesis.Root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header2.Hash()).To(Equal(genesis.Hash()))
+
+		header3, err := p.GetHeaderByStateRoot(header.Root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header3.Hash()).To(Equal(header.Hash()))
+
+		_, err = p.GetHeaderByStateRoot(common.Hash{0x08})
+		Expect(err).To(MatchError(core.ErrHeaderNotFound))
+	})
+
 	It("should be able to prune headers", func() {
 		toAdd := int64(prevHeaderHashes + 5) // the first 5 hashes will eventually get deleted
 		var deletedHashes []common.Hash
@@ -24,6 +24,8 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // headerHashKeySize is the number of bytes in the header hash key: 1 (prefix) + 8 (block height).
@@ -36,3 +38,12 @@ func headerHashKeyForHeight(number int64) []byte {
 	copy(bz[1:], sdk.Uint64ToBigEndian(uint64(number%prevHeaderHashes)))
 	return bz
 }
+
+// stateRootKeyFor returns the key under which the number of the block with the given state root
+// is stored.
+func stateRootKeyFor(root common.Hash) []byte {
+	bz := make([]byte, 1+common.HashLength)
+	copy(bz, []byte{types.StateRootKeyPrefix})
+	copy(bz[1:], root[:])
+	return bz
+}
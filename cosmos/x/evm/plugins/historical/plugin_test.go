@@ -79,9 +79,15 @@ var _ = Describe("Historical Data", func() {
 	When("Other blocks", func() {
 		It("should correctly store and return blocks", func() {
 			ctx = ctx.WithBlockHeight(1)
+			excessBlobGas, blobGasUsed := uint64(0), uint64(0)
 			header := &ethtypes.Header{
-				Number:   big.NewInt(1),
-				GasLimit: 1000,
+				Number:           big.NewInt(1),
+				GasLimit:         1000,
+				BaseFee:          big.NewInt(1),
+				WithdrawalsHash:  &ethtypes.EmptyWithdrawalsHash,
+				ExcessBlobGas:    &excessBlobGas,
+				BlobGasUsed:      &blobGasUsed,
+				ParentBeaconRoot: &common.Hash{},
 			}
 			tx := ethtypes.NewTransaction(
 				0, common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), []byte{0x12},
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// dynamicRecord is the record of a dynamic precompile instance, which is kept in the evm store.
+type dynamicRecord struct {
+	// Kind is the kind of the dynamic precompile.
+	Kind string
+	// Args are the arguments that the instance is built from.
+	Args string
+	// Paused is true if the calls to the instance revert.
+	Paused bool
+}
+
+// dynamicPrecompiles holds the kinds of the dynamic precompiles and the containers built for their
+// instances, which are shared by all the views of the plugin.
+type dynamicPrecompiles struct {
+	mu sync.RWMutex
+	// kinds are the constructors of the dynamic precompiles, indexed by their kind.
+	kinds map[string]ethprecompile.DynamicConstructor
+	// containers are the precompile containers of the instances, indexed by their address.
+	containers map[common.Address]vm.PrecompiledContract
+	// instances indexes the addresses of the instances ever found in the evm store, so that the
+	// store is only read for the addresses of instances. It is loaded from the store once per
+	// block.
+	instances map[common.Address]struct{}
+	// indexedHeight is the highest block height that the instances are loaded from.
+	indexedHeight int64
+	// plf registers the events of the instances, if set.
+	plf LogFactory
+}
+
+// DynamicPrecompileAddress returns the address of the dynamic precompile of the given kind, which
+// is instantiated with the given arguments.
+func DynamicPrecompileAddress(kind, args string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(kind), []byte{0}, []byte(args)))
+}
+
+// RegisterDynamicKind registers a kind of dynamic precompiles, which are instantiated by
+// governance with the given constructor.
+func (p *plugin) RegisterDynamicKind(kind string, constructor ethprecompile.DynamicConstructor) {
+	p.dynamic.mu.Lock()
+	defer p.dynamic.mu.Unlock()
+	p.dynamic.kinds[kind] = constructor
+}
+
+// SetPrecompileLogFactory sets the log factory, which registers the events of the instances.
+func (p *plugin) SetPrecompileLogFactory(plf LogFactory) {
+	p.dynamic.mu.Lock()
+	defer p.dynamic.mu.Unlock()
+	p.dynamic.plf = plf
+}
+
+// WithContext returns a view of the plugin, which also gets the dynamic precompiles instantiated
+// in the evm store of the given context.
+//
+// WithContext implements ethprecompile.ContextualPlugin.
+func (p *plugin) WithContext(ctx context.Context) ethprecompile.Plugin {
+	if p.storeKey == nil || ctx == nil || sdk.UnwrapSDKContext(ctx).MultiStore() == nil {
+		return p
+	}
+	bound := *p
+	bound.ctx = ctx
+	return &bound
+}
+
+// InstantiatePrecompile instantiates a dynamic precompile of the given kind with the given
+// arguments and returns its address, which is derived from the kind and the arguments.
+func (p *plugin) InstantiatePrecompile(
+	ctx context.Context, kind, args string,
+) (common.Address, error) {
+	addr := DynamicPrecompileAddress(kind, args)
+	store := p.dynamicStore(ctx)
+	if p.Registry.Has(addr) || store.Has(dynamicPrecompileKey(addr)) {
+		return common.Address{}, errorslib.Wrap(ErrPrecompileExists, addr.Hex())
+	}
+
+	// build the instance now, so that invalid arguments are rejected by the constructor
+	record := &dynamicRecord{Kind: kind, Args: args}
+	if _, err := p.dynamicContainer(addr, record); err != nil {
+		return common.Address{}, err
+	}
+	if err := setDynamicRecord(store, addr, record); err != nil {
+		return common.Address{}, err
+	}
+	p.indexInstance(ctx, addr)
+	return addr, nil
+}
+
+// SetPrecompilePaused pauses or resumes the dynamic precompile at the given address. The calls to
+// a paused precompile revert.
+func (p *plugin) SetPrecompilePaused(ctx context.Context, addr common.Address, paused bool) error {
+	store := p.dynamicStore(ctx)
+	record, err := getDynamicRecord(store, addr)
+	if err != nil {
+		return err
+	}
+	record.Paused = paused
+	return setDynamicRecord(store, addr, record)
+}
+
+// RemovePrecompile removes the dynamic precompile at the given address, which then runs as an
+// account without code.
+func (p *plugin) RemovePrecompile(ctx context.Context, addr common.Address) error {
+	store := p.dynamicStore(ctx)
+	if _, err := getDynamicRecord(store, addr); err != nil {
+		return err
+	}
+	store.Delete(dynamicPrecompileKey(addr))
+	return nil
+}
+
+// getDynamic returns the dynamic precompile at the given address, if the plugin is bound to a
+// context that holds an instance at the address.
+func (p *plugin) getDynamic(addr common.Address) (vm.PrecompiledContract, bool) {
+	if p.ctx == nil || !p.isInstance(p.ctx, addr) {
+		return nil, false
+	}
+	record, err := getDynamicRecord(p.dynamicStore(p.ctx), addr)
+	if err != nil {
+		return nil, false
+	}
+	container, err := p.dynamicContainer(addr, record)
+	if err != nil {
+		return nil, false
+	}
+	return container, true
+}
+
+// getActiveDynamic returns the addresses of the dynamic precompiles, if the plugin is bound to a
+// context.
+func (p *plugin) getActiveDynamic() []common.Address {
+	if p.ctx == nil {
+		return nil
+	}
+	p.loadInstances(p.ctx)
+
+	p.dynamic.mu.RLock()
+	indexed := make([]common.Address, 0, len(p.dynamic.instances))
+	for addr := range p.dynamic.instances {
+		indexed = append(indexed, addr)
+	}
+	p.dynamic.mu.RUnlock()
+	slices.SortFunc(indexed, func(a, b common.Address) int { return a.Cmp(b) })
+
+	var active []common.Address
+	for _, addr := range indexed {
+		if _, found := p.getDynamic(addr); found {
+			active = append(active, addr)
+		}
+	}
+	return active
+}
+
+// isInstance returns whether the given address is in the index of the instances, in which case
+// the evm store may hold an instance at the address.
+func (p *plugin) isInstance(ctx context.Context, addr common.Address) bool {
+	p.loadInstances(ctx)
+	p.dynamic.mu.RLock()
+	defer p.dynamic.mu.RUnlock()
+	_, found := p.dynamic.instances[addr]
+	return found
+}
+
+// indexInstance adds the given address to the index of the instances.
+func (p *plugin) indexInstance(ctx context.Context, addr common.Address) {
+	p.loadInstances(ctx)
+	p.dynamic.mu.Lock()
+	defer p.dynamic.mu.Unlock()
+	p.dynamic.instances[addr] = struct{}{}
+}
+
+// loadInstances adds the instances in the evm store of the given context to the index, unless
+// the index is already loaded from the block of the context or from a later block. The index is
+// never pruned, so it is a superset of the instances of every context.
+func (p *plugin) loadInstances(ctx context.Context) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	p.dynamic.mu.RLock()
+	indexed := height <= p.dynamic.indexedHeight
+	p.dynamic.mu.RUnlock()
+	if indexed {
+		return
+	}
+
+	var instances []common.Address
+	iter := storetypes.KVStorePrefixIterator(
+		p.dynamicStore(ctx), []byte{types.DynamicPrecompileKeyPrefix},
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		instances = append(instances, common.BytesToAddress(iter.Key()[1:]))
+	}
+
+	p.dynamic.mu.Lock()
+	defer p.dynamic.mu.Unlock()
+	for _, addr := range instances {
+		p.dynamic.instances[addr] = struct{}{}
+	}
+	p.dynamic.indexedHeight = max(p.dynamic.indexedHeight, height)
+}
+
+// isPaused returns whether the given precompile is a paused dynamic precompile.
+func (p *plugin) isPaused(ctx context.Context, addr common.Address) bool {
+	if p.storeKey == nil || p.Registry.Has(addr) || !p.isInstance(ctx, addr) {
+		return false
+	}
+	record, err := getDynamicRecord(p.dynamicStore(ctx), addr)
+	return err == nil && record.Paused
+}
+
+// dynamicContainer returns the precompile container of the instance at the given address, which
+// is built and cached on first use. The events of a new instance are registered with the log
+// factory before it runs.
+func (p *plugin) dynamicContainer(
+	addr common.Address, record *dynamicRecord,
+) (vm.PrecompiledContract, error) {
+	p.dynamic.mu.RLock()
+	container, found := p.dynamic.containers[addr]
+	constructor, known := p.dynamic.kinds[record.Kind]
+	p.dynamic.mu.RUnlock()
+	if found {
+		return container, nil
+	}
+	if !known {
+		return nil, errorslib.Wrap(ErrUnknownDynamicKind, record.Kind)
+	}
+
+	impl, err := constructor(addr, record.Args)
+	if err != nil {
+		return nil, err
+	}
+	// the container is shared by all views, so it must not be built with a bound plugin
+	unbound := *p
+	unbound.ctx, unbound.block = nil, nil
+	if container, err = ethprecompile.NewStatefulFactory().Build(impl, &unbound); err != nil {
+		return nil, err
+	}
+
+	p.dynamic.mu.Lock()
+	defer p.dynamic.mu.Unlock()
+	if cached, ok := p.dynamic.containers[addr]; ok {
+		return cached, nil
+	}
+	if p.dynamic.plf != nil {
+		p.dynamic.plf.RegisterInstance(impl)
+	}
+	p.dynamic.containers[addr] = container
+	return container, nil
+}
+
+// dynamicStore returns the evm store of the given context, which is accessed without charging
+// any gas.
+func (p *plugin) dynamicStore(ctx context.Context) storetypes.KVStore {
+	return sdk.UnwrapSDKContext(ctx).MultiStore().GetKVStore(p.storeKey)
+}
+
+// setDynamicRecord writes the record of the dynamic precompile at the given address.
+func setDynamicRecord(store storetypes.KVStore, addr common.Address, record *dynamicRecord) error {
+	bz, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return err
+	}
+	store.Set(dynamicPrecompileKey(addr), bz)
+	return nil
+}
+
+// getDynamicRecord reads the record of the dynamic precompile at the given address.
+func getDynamicRecord(store storetypes.KVStore, addr common.Address) (*dynamicRecord, error) {
+	bz := store.Get(dynamicPrecompileKey(addr))
+	if bz == nil {
+		return nil, errorslib.Wrap(ErrPrecompileNotFound, addr.Hex())
+	}
+	record := new(dynamicRecord)
+	if err := rlp.DecodeBytes(bz, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// dynamicPrecompileKey returns the key of the record of the dynamic precompile at the given
+// address.
+func dynamicPrecompileKey(addr common.Address) []byte {
+	return append([]byte{types.DynamicPrecompileKeyPrefix}, addr.Bytes()...)
+}
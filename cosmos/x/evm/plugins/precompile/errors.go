@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import "errors"
+
+var (
+	// ErrPrecompileNotFound is returned when no precompile is registered at an address.
+	ErrPrecompileNotFound = errors.New("no precompile is registered at this address")
+	// ErrNoGasSchedule is returned when the gas schedule of a precompile method cannot be set.
+	ErrNoGasSchedule = errors.New("this precompile does not have a gas schedule for this method")
+	// ErrUnknownDynamicKind is returned when no dynamic precompiles of a kind are registered.
+	ErrUnknownDynamicKind = errors.New("no dynamic precompiles of this kind are registered")
+	// ErrPrecompileExists is returned when a precompile is already registered at an address.
+	ErrPrecompileExists = errors.New("a precompile is already registered at this address")
+	// ErrPrecompilePaused is returned when a paused dynamic precompile is called.
+	ErrPrecompilePaused = errors.New("this precompile is paused")
+	// ErrInvalidDynamicAddress is returned when the address of a dynamic precompile in the
+	// genesis is not derived from its kind and arguments.
+	ErrInvalidDynamicAddress = errors.New("the address of this dynamic precompile is invalid")
+)
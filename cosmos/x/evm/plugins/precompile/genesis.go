@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// InitPrecompileGenesis sets the dynamic precompiles and the base gas overrides of the given
+// precompile state in the evm store. The instances are set first, so that the base gas of their
+// methods can be overridden.
+func (p *plugin) InitPrecompileGenesis(ctx sdk.Context, pg *types.PrecompileGenesis) error {
+	if pg == nil {
+		return nil
+	}
+
+	store := p.dynamicStore(ctx)
+	for _, dp := range pg.Dynamic {
+		if addr := DynamicPrecompileAddress(dp.Kind, dp.Args); addr != dp.Address {
+			return errorslib.Wrapf(
+				ErrInvalidDynamicAddress, "%s: want %s", dp.Address.Hex(), addr.Hex(),
+			)
+		}
+		record := &dynamicRecord{Kind: dp.Kind, Args: dp.Args, Paused: dp.Paused}
+		if _, err := p.dynamicContainer(dp.Address, record); err != nil {
+			return err
+		}
+		if err := setDynamicRecord(store, dp.Address, record); err != nil {
+			return err
+		}
+		p.indexInstance(ctx, dp.Address)
+	}
+
+	for _, mbg := range pg.MethodBaseGas {
+		if err := p.SetMethodBaseGas(ctx, mbg.Precompile, mbg.Method, mbg.BaseGas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportPrecompileGenesis returns the dynamic precompiles and the base gas overrides in the evm
+// store.
+func (p *plugin) ExportPrecompileGenesis(ctx sdk.Context) (*types.PrecompileGenesis, error) {
+	pg := new(types.PrecompileGenesis)
+	store := p.dynamicStore(ctx)
+
+	iter := storetypes.KVStorePrefixIterator(store, []byte{types.DynamicPrecompileKeyPrefix})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := common.BytesToAddress(iter.Key()[1:])
+		record, err := getDynamicRecord(store, addr)
+		if err != nil {
+			return nil, err
+		}
+		pg.Dynamic = append(pg.Dynamic, types.DynamicPrecompile{
+			Address: addr, Kind: record.Kind, Args: record.Args, Paused: record.Paused,
+		})
+	}
+
+	gasIter := storetypes.KVStorePrefixIterator(store, []byte{types.PrecompileBaseGasKeyPrefix})
+	defer gasIter.Close()
+	for ; gasIter.Valid(); gasIter.Next() {
+		key := gasIter.Key()[1:]
+		pg.MethodBaseGas = append(pg.MethodBaseGas, types.MethodBaseGas{
+			Precompile: common.BytesToAddress(key[:common.AddressLength]),
+			Method:     string(key[common.AddressLength:]),
+			BaseGas:    sdk.BigEndianToUint64(gasIter.Value()),
+		})
+	}
+	return pg, nil
+}
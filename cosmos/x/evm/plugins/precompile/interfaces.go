@@ -20,7 +20,11 @@
 
 package precompile
 
-import storetypes "cosmossdk.io/store/types"
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+)
 
 type (
 	StatePlugin interface {
@@ -32,4 +36,10 @@ type (
 		IsReadOnly() bool
 		SetReadOnly(bool)
 	}
+
+	// LogFactory registers the Ethereum events of the dynamic precompiles, so that the Cosmos
+	// events emitted by their instances are logged at the addresses of the instances.
+	LogFactory interface {
+		RegisterInstance(ethprecompile.DynamicImpl)
+	}
 )
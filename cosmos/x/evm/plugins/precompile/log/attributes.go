@@ -23,6 +23,7 @@ package log
 import (
 	"strconv"
 
+	libgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
 	"github.com/berachain/polaris/eth/accounts/abi"
 	"github.com/berachain/polaris/eth/core/precompile"
@@ -84,7 +85,7 @@ func ConvertSdkCoins(attributeValue string) (any, error) {
 		return nil, err
 	}
 	// convert to geth compatible coins
-	evmCoins := cosmlib.SdkCoinsToEvmCoins(coins)
+	evmCoins := cosmlib.SdkCoinsToEvmCoins[libgenerated.CosmosCoin](coins)
 	return evmCoins, nil
 }
 
@@ -21,6 +21,8 @@
 package log
 
 import (
+	"sync"
+
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state/events"
 	"github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/lib/registry"
@@ -29,14 +31,20 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // Factory is a `PrecompileLogFactory` that builds Ethereum logs from Cosmos events. All Ethereum
 // events must be registered with the factory before it can build logs during state transitions.
 type Factory struct {
+	// mu guards the registered events, as dynamic precompiles register their events at runtime.
+	mu sync.RWMutex
 	// events is a registry of precompile logs, indexed by the Cosmos event type.
 	events libtypes.Registry[string, *precompileLog]
+	// instances holds the precompile logs of the dynamic precompiles, indexed by the precompile
+	// address and the Cosmos event type, as instances of the same kind emit the same events.
+	instances map[common.Address]map[string]*precompileLog
 	// customValueDecoders is a map of Cosmos attribute keys to attribute value decoder
 	// functions for custom events.
 	customValueDecoders precompile.ValueDecoders
@@ -47,6 +55,7 @@ type Factory struct {
 func NewFactory(precompiles []precompile.Registrable) *Factory {
 	f := &Factory{
 		events:              registry.NewMap[string, *precompileLog](),
+		instances:           make(map[common.Address]map[string]*precompileLog),
 		customValueDecoders: make(precompile.ValueDecoders),
 	}
 	f.registerAllEvents(precompiles)
@@ -57,8 +66,45 @@ func NewFactory(precompiles []precompile.Registrable) *Factory {
 //
 // Build implements `events.PrecompileLogFactory`.
 func (f *Factory) Build(event *sdk.Event) (*ethtypes.Log, error) {
-	// get the precompile log for the Cosmos event type
-	pl := f.events.Get(event.Type)
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.build(f.events.Get(event.Type), event)
+}
+
+// BuildFor builds an Ethereum log from a Cosmos event, which is emitted during the execution of
+// the precompile at the given address. The events of a dynamic precompile are logged at the
+// address of the instance; all other events are built as in `Build`.
+//
+// BuildFor implements `events.InstanceLogFactory`.
+func (f *Factory) BuildFor(precompile common.Address, event *sdk.Event) (*ethtypes.Log, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if pl, found := f.instances[precompile][event.Type]; found {
+		return f.build(pl, event)
+	}
+	return f.build(f.events.Get(event.Type), event)
+}
+
+// RegisterInstance registers the Ethereum events of the given dynamic precompile with the
+// factory, which are logged at the address of the instance.
+func (f *Factory) RegisterInstance(pc precompile.DynamicImpl) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	logs := make(map[string]*precompileLog)
+	for _, event := range pc.ABIEvents() {
+		pl := newPrecompileLog(pc.RegistryKey(), event)
+		logs[pl.RegistryKey()] = pl
+	}
+	f.instances[pc.RegistryKey()] = logs
+
+	for attr, decoder := range pc.CustomValueDecoders() {
+		f.customValueDecoders[attr] = decoder
+	}
+}
+
+// build builds an Ethereum log from a Cosmos event with the given precompile log.
+func (f *Factory) build(pl *precompileLog, event *sdk.Event) (*ethtypes.Log, error) {
 	if pl == nil {
 		return nil, events.ErrEthEventNotRegistered
 	}
@@ -27,6 +27,7 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
+	libgenerated "github.com/berachain/polaris/contracts/bindings/cosmos/lib"
 	cosmlib "github.com/berachain/polaris/cosmos/lib"
 	testutil "github.com/berachain/polaris/cosmos/testutil"
 	"github.com/berachain/polaris/eth/accounts/abi"
@@ -123,7 +124,7 @@ var _ = Describe("Factory", func() {
 			))
 			Expect(log.Topics[1]).To(Equal(crypto.Keccak256Hash([]byte(delAddr.String()))))
 			packedData, err := mockDefaultAbiEvent().Inputs.NonIndexed().Pack(
-				cosmlib.SdkCoinsToEvmCoins(sdk.NewCoins(amt)), creationHeight,
+				cosmlib.SdkCoinsToEvmCoins[libgenerated.CosmosCoin](sdk.NewCoins(amt)), creationHeight,
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(log.Data).To(Equal(packedData))
@@ -156,12 +157,49 @@ var _ = Describe("Factory", func() {
 			))
 			Expect(log.Topics[1]).To(Equal(common.BytesToHash(valAddr.Bytes())))
 			packedData, err := mockCustomAbiEvent()["CustomUnbondingDelegation"].
-				Inputs.NonIndexed().Pack(cosmlib.SdkCoinsToEvmCoins(sdk.NewCoins(amt)))
+				Inputs.NonIndexed().
+				Pack(cosmlib.SdkCoinsToEvmCoins[libgenerated.CosmosCoin](sdk.NewCoins(amt)))
 			Expect(err).ToNot(HaveOccurred())
 			Expect(log.Data).To(Equal(packedData))
 		})
 	})
 
+	When("building Eth logs of dynamic precompiles", func() {
+		It("should build the logs of an instance at its address", func() {
+			instance := mock.NewStatefulImpl()
+			instance.RegistryKeyFunc = func() common.Address {
+				return common.BytesToAddress([]byte{0x04})
+			}
+			instance.ABIEventsFunc = func() map[string]abi.Event {
+				return map[string]abi.Event{
+					"CancelUnbondingDelegation": mockDefaultAbiEvent(),
+				}
+			}
+			instance.CustomValueDecodersFunc = func() precompile.ValueDecoders {
+				return nil
+			}
+			f.RegisterInstance(&mockInstance{instance})
+
+			event := sdk.NewEvent(
+				"cancel_unbonding_delegation",
+				sdk.NewAttribute("amount", amt.String()),
+				sdk.NewAttribute("creation_height", strconv.FormatInt(creationHeight, 10)),
+				sdk.NewAttribute("option", delAddr.String()),
+			)
+			log, err := f.BuildFor(common.BytesToAddress([]byte{0x04}), &event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Address).To(Equal(common.BytesToAddress([]byte{0x04})))
+
+			// the events of other precompiles are logged at their registered address
+			log, err = f.BuildFor(common.BytesToAddress([]byte{0x05}), &event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Address).To(Equal(common.BytesToAddress([]byte{0x01})))
+			log, err = f.Build(&event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Address).To(Equal(common.BytesToAddress([]byte{0x01})))
+		})
+	})
+
 	When("building invalid Cosmos events", func() {
 		It("should not find the custom value decoder", func() {
 			pc.RegistryKeyFunc = func() common.Address {
@@ -261,6 +299,14 @@ var _ = Describe("Factory", func() {
 
 // MOCKS BELOW.
 
+type mockInstance struct {
+	*mock.StatefulImplMock
+}
+
+func (mi *mockInstance) Name() string {
+	return "mock"
+}
+
 func mockCustomAbiEvent() map[string]abi.Event {
 	addrType, _ := abi.NewType("address", "address", nil)
 	coinType, _ := abi.NewType("tuple[]", "structIStakingModule.Coin[]", []abi.ArgumentMarshaling{
@@ -309,7 +355,7 @@ var cvd = precompile.ValueDecoders{
 		if err != nil {
 			return nil, err
 		}
-		evmCoins := cosmlib.SdkCoinsToEvmCoins(sdk.Coins{coin})
+		evmCoins := cosmlib.SdkCoinsToEvmCoins[libgenerated.CosmosCoin](sdk.Coins{coin})
 		return evmCoins, nil
 	},
 }
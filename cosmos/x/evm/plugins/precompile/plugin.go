@@ -21,6 +21,7 @@
 package precompile
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -28,10 +29,12 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	ethstate "github.com/berachain/polaris/eth/core/state"
 	pvm "github.com/berachain/polaris/eth/core/vm"
+	errorslib "github.com/berachain/polaris/lib/errors"
 	"github.com/berachain/polaris/lib/registry"
 	libtypes "github.com/berachain/polaris/lib/types"
 	"github.com/berachain/polaris/lib/utils"
@@ -47,7 +50,36 @@ import (
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	core.PrecompilePlugin
+	// AtBlock returns a view of the plugin bound to the block with the given number and timestamp.
+	AtBlock(number *big.Int, time uint64) ethprecompile.Plugin
+	// WithContext returns a view of the plugin, which also gets the dynamic precompiles
+	// instantiated in the evm store of the given context.
+	WithContext(ctx context.Context) ethprecompile.Plugin
 	RegisterPrecompiles([]ethprecompile.Registrable) error
+	// RegisterDynamicKind registers a kind of dynamic precompiles, which are instantiated by
+	// governance with the given constructor.
+	RegisterDynamicKind(kind string, constructor ethprecompile.DynamicConstructor)
+	// SetPrecompileLogFactory sets the log factory, which registers the events of the dynamic
+	// precompiles.
+	SetPrecompileLogFactory(LogFactory)
+	// InstantiatePrecompile instantiates a dynamic precompile of the given kind with the given
+	// arguments and returns its address.
+	InstantiatePrecompile(ctx context.Context, kind, args string) (common.Address, error)
+	// SetPrecompilePaused pauses or resumes the dynamic precompile at the given address.
+	SetPrecompilePaused(ctx context.Context, precompile common.Address, paused bool) error
+	// RemovePrecompile removes the dynamic precompile at the given address.
+	RemovePrecompile(ctx context.Context, precompile common.Address) error
+	// SetMethodBaseGas sets the base gas of the given method of the stateful precompile at the
+	// given address, which overrides the base gas of the gas schedule declared by the precompile.
+	SetMethodBaseGas(
+		ctx context.Context, precompile common.Address, method string, baseGas uint64,
+	) error
+	// InitPrecompileGenesis sets the dynamic precompiles and the base gas overrides of the given
+	// precompile state in the evm store.
+	InitPrecompileGenesis(ctx sdk.Context, pg *types.PrecompileGenesis) error
+	// ExportPrecompileGenesis returns the dynamic precompiles and the base gas overrides in the
+	// evm store.
+	ExportPrecompileGenesis(ctx sdk.Context) (*types.PrecompileGenesis, error)
 }
 
 // PolarStateDB is the interface that must be implemented by the state DB.
@@ -60,16 +92,42 @@ type PolarStateDB interface {
 // plugin runs precompile containers in the Cosmos environment with the context gas configs.
 type plugin struct {
 	libtypes.Registry[common.Address, vm.PrecompiledContract]
+	// schedules are the activation schedules of the precompiles that are added or retired in an
+	// upgrade of the chain.
+	schedules map[common.Address]ethprecompile.Schedule
+	// upgrades are the blocks from which on the stateful precompiles change their behaviour.
+	upgrades ethprecompile.Upgrades
+	// block is the block that the plugin is bound to, nil if the plugin is not bound to a block.
+	block *boundBlock
+	// dynamic holds the kinds and the instances of the dynamic precompiles.
+	dynamic *dynamicPrecompiles
+	// ctx is the context that the plugin is bound to, nil if the plugin is not bound to a context.
+	// The dynamic precompiles are only found in a bound plugin.
+	ctx context.Context
+	// storeKey is the store key of the evm store, which holds the base gas set by governance and
+	// the dynamic precompiles.
+	storeKey storetypes.StoreKey
 	// kvGasConfig is the gas config for the KV store.
 	kvGasConfig storetypes.GasConfig
 	// transientKVGasConfig is the gas config for the transient KV store.
 	transientKVGasConfig storetypes.GasConfig
 }
 
-// NewPlugin creates and returns a plugin with the default KV store gas configs.
-func NewPlugin() Plugin {
+// NewPlugin creates and returns a plugin with the default KV store gas configs, which upgrades
+// the stateful precompiles at the blocks of the given upgrades.
+func NewPlugin(storeKey storetypes.StoreKey, upgrades ethprecompile.Upgrades) Plugin {
 	return &plugin{
-		Registry: registry.NewMap[common.Address, vm.PrecompiledContract](),
+		Registry:  registry.NewMap[common.Address, vm.PrecompiledContract](),
+		schedules: make(map[common.Address]ethprecompile.Schedule),
+		upgrades:  upgrades,
+		dynamic: &dynamicPrecompiles{
+			kinds:      make(map[string]ethprecompile.DynamicConstructor),
+			containers: make(map[common.Address]vm.PrecompiledContract),
+			instances:  make(map[common.Address]struct{}),
+			// the instances are not loaded from any block yet
+			indexedHeight: -1,
+		},
+		storeKey: storeKey,
 		// NOTE: these are hardcoded as they are also hardcoded in the sdk.
 		// This should be updated if it ever changes.
 		kvGasConfig:          storetypes.KVGasConfig(),
@@ -77,10 +135,33 @@ func NewPlugin() Plugin {
 	}
 }
 
+// boundBlock is the number and timestamp of the block that a plugin is bound to.
+type boundBlock struct {
+	number *big.Int
+	time   uint64
+}
+
+// AtBlock returns a view of the plugin bound to the block with the given number and timestamp,
+// which only gets the precompiles that are active in the block.
+//
+// AtBlock implements ethprecompile.ScheduledPlugin.
+func (p *plugin) AtBlock(number *big.Int, time uint64) ethprecompile.Plugin {
+	bound := *p
+	bound.block = &boundBlock{number: number, time: time}
+	return &bound
+}
+
+// Get returns the precompile at the given address, if it is active in the block that the plugin
+// is bound to. A plugin that is not bound to a block gets every registered precompile, and a
+// plugin that is bound to a context also gets the dynamic precompiles.
+//
+// Get implements core.PrecompilePlugin.
 func (p *plugin) Get(addr common.Address, _ *params.Rules) (vm.PrecompiledContract, bool) {
-	// TODO: handle rules
 	val := p.Registry.Get(addr)
 	if val == nil {
+		return p.getDynamic(addr)
+	}
+	if !p.isActive(addr) {
 		return nil, false
 	}
 	return val, true
@@ -108,18 +189,56 @@ func (p *plugin) RegisterPrecompiles(precompiles []ethprecompile.Registrable) er
 		if err != nil {
 			return err
 		}
+
+		// keep the activation schedule of the precompile, if any
+		if si, ok := utils.GetAs[ethprecompile.ScheduledImpl](pc); ok {
+			p.schedules[pc.RegistryKey()] = si.ActivationSchedule()
+		}
 	}
 	return nil
 }
 
+// GetActive returns the addresses of the precompiles that are active in the block that the plugin
+// is bound to.
+//
 // GetActive implements core.PrecompilePlugin.
 func (p *plugin) GetActive(_ params.Rules) []common.Address {
-	// TODO: enable hardfork activation and de-activation.
 	active := make([]common.Address, 0)
 	for k := range p.Registry.Iterate() {
-		active = append(active, k)
+		if p.isActive(k) {
+			active = append(active, k)
+		}
+	}
+	return append(active, p.getActiveDynamic()...)
+}
+
+// isActive returns whether the precompile at the given address is active in the block that the
+// plugin is bound to. Precompiles without an activation schedule are always active.
+func (p *plugin) isActive(addr common.Address) bool {
+	schedule, found := p.schedules[addr]
+	return !found || p.block == nil || schedule.IsActive(p.block.number, p.block.time)
+}
+
+// pcAddress returns the address of the given precompile container.
+func pcAddress(pc vm.PrecompiledContract) common.Address {
+	if r, ok := utils.GetAs[ethprecompile.Registrable](pc); ok {
+		return r.RegistryKey()
+	}
+	return common.Address{}
+}
+
+// isActiveInEVM returns whether the given precompile is active in the block of the given EVM.
+func (p *plugin) isActiveInEVM(pc vm.PrecompiledContract, evm vm.PrecompileEVM) bool {
+	r, ok := utils.GetAs[ethprecompile.Registrable](pc)
+	if !ok {
+		return true
+	}
+	schedule, found := p.schedules[r.RegistryKey()]
+	if !found {
+		return true
 	}
-	return active
+	bc := evm.GetContext()
+	return schedule.IsActive(bc.BlockNumber, bc.Time)
 }
 
 // Run runs the a precompile container and returns the remaining gas after execution by injecting
@@ -139,12 +258,42 @@ func (p *plugin) Run(
 	ms := utils.MustGetAs[MultiStore](ctx.MultiStore())
 	cem := utils.MustGetAs[state.ControllableEventManager](ctx.EventManager())
 
-	requiredGas := pc.RequiredGas(input)
+	// a precompile that is not active in the block of the EVM runs as an account without code, as
+	// the plugin may not be bound to the block that the EVM executes
+	if !p.isActiveInEVM(pc, evm) {
+		return nil, suppliedGas, nil
+	}
+
+	// a paused dynamic precompile reverts every call
+	addr := pcAddress(pc)
+	if p.isPaused(ctx, addr) {
+		return nil, suppliedGas, errorslib.Wrapf(
+			vm.ErrExecutionReverted, "%v: %s", ErrPrecompilePaused, addr.Hex(),
+		)
+	}
+
+	// since their upgrade, calls with value to methods that are not payable revert, as the value
+	// transferred to the precompile before the call would be locked in the precompile
+	number := evm.GetContext().BlockNumber
+	if value != nil && value.Sign() > 0 && p.upgrades.IsNonPayable(number) {
+		if pac, ok := utils.GetAs[ethprecompile.PayableContract](pc); ok && !pac.IsPayable(input) {
+			return nil, suppliedGas, errorslib.Wrapf(
+				vm.ErrExecutionReverted, "%v: %s", ethprecompile.ErrNonPayableMethod, addr.Hex(),
+			)
+		}
+	}
+
+	requiredGas := p.requiredGas(ctx, number, pc, input)
 	// handle edge case when not enough gas is provided for even the required gas
 	if requiredGas > suppliedGas {
 		return nil, 0, vm.ErrOutOfGas
 	}
 
+	// view and pure methods are always run in read-only mode, as in a static call
+	if roc, ok := utils.GetAs[ethprecompile.ReadOnlyContract](pc); ok && roc.IsReadOnly(input) {
+		readOnly = true
+	}
+
 	// make sure the readOnly is only set if we aren't in readOnly yet, which also makes sure that
 	// the readOnly flag isn't removed for child calls (taken from geth core/vm/interpreter.go)
 	if readOnly && !ms.IsReadOnly() {
@@ -156,6 +305,9 @@ func (p *plugin) Run(
 		}()
 	}
 
+	// build the Eth logs of the emitted Cosmos events for this precompile
+	defer cem.SetPrecompile(cem.SetPrecompile(addr))
+
 	// disable reentrancy into the EVM only during precompile execution
 	p.disableReentrancy(sdb)
 	defer p.enableReentrancy(sdb)
@@ -167,11 +319,17 @@ func (p *plugin) Run(
 	gm := storetypes.NewGasMeter(suppliedGas)
 	gm.ConsumeGas(requiredGas, "precompile required gas")
 
+	// report the decoded method and the Cosmos effects of the precompile to the EVM's tracer
+	runCtx := ctx
+	if tracer, ok := tracerOf(evm); ok {
+		runCtx = traceContext(ctx, tracer, pc, addr, input)
+	}
+
 	// run the precompile container
 	{
 		defer telemetry.MeasureSince(time.Now(), MetricKeyTime)
 		ret, err = pc.Run(
-			ctx.WithGasMeter(gm).
+			runCtx.WithGasMeter(gm).
 				WithKVGasConfig(p.kvGasConfig).
 				WithTransientKVGasConfig(p.transientKVGasConfig),
 			evm,
@@ -185,6 +343,63 @@ func (p *plugin) Run(
 	return //nolint:nakedret // named returns.
 }
 
+// SetMethodBaseGas implements Plugin.
+func (p *plugin) SetMethodBaseGas(
+	ctx context.Context, precompile common.Address, method string, baseGas uint64,
+) error {
+	// the plugin is bound to the context, so that the base gas of dynamic precompiles can be set
+	pc, found := p.WithContext(ctx).Get(precompile, nil)
+	if !found {
+		return errorslib.Wrap(ErrPrecompileNotFound, precompile.Hex())
+	}
+	mgc, ok := utils.GetAs[ethprecompile.MethodGasContract](pc)
+	if !ok || !mgc.HasMethod(method) {
+		return errorslib.Wrapf(ErrNoGasSchedule, "%s: %s", precompile.Hex(), method)
+	}
+
+	sdk.UnwrapSDKContext(ctx).MultiStore().GetKVStore(p.storeKey).Set(
+		methodBaseGasKey(precompile, method), sdk.Uint64ToBigEndian(baseGas),
+	)
+	return nil
+}
+
+// requiredGas returns the gas required to run the precompile with the given input in the block
+// with the given number. The gas schedules of the methods are only charged from the block of
+// their upgrade on, and the base gas of the method's gas schedule is replaced by the base gas set
+// by governance, if any.
+func (p *plugin) requiredGas(
+	ctx sdk.Context, number *big.Int, pc vm.PrecompiledContract, input []byte,
+) uint64 {
+	mgc, ok := utils.GetAs[ethprecompile.MethodGasContract](pc)
+	switch {
+	case !ok:
+		return pc.RequiredGas(input)
+	case !p.upgrades.IsGasSchedules(number):
+		return 0
+	case p.storeKey == nil:
+		return pc.RequiredGas(input)
+	}
+	method, gas, found := mgc.MethodGas(input)
+	if !found {
+		return pc.RequiredGas(input)
+	}
+
+	// read the base gas from the evm store without charging any gas
+	if bz := ctx.MultiStore().GetKVStore(p.storeKey).Get(
+		methodBaseGasKey(utils.MustGetAs[ethprecompile.Registrable](pc).RegistryKey(), method),
+	); bz != nil {
+		gas.Base = sdk.BigEndianToUint64(bz)
+	}
+	return gas.RequiredGas(input)
+}
+
+// methodBaseGasKey returns the key of the base gas of the given precompile method.
+func methodBaseGasKey(precompile common.Address, method string) []byte {
+	return append(
+		append([]byte{types.PrecompileBaseGasKeyPrefix}, precompile.Bytes()...), method...,
+	)
+}
+
 // EnableReentrancy sets the state so that execution can enter the EVM again.
 //
 // EnableReentrancy implements core.PrecompilePlugin.
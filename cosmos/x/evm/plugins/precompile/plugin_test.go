@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -34,6 +35,9 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state/events"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state/events/mock"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/accounts/abi"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	ethstate "github.com/berachain/polaris/eth/core/state"
 	pvm "github.com/berachain/polaris/eth/core/vm"
 	"github.com/berachain/polaris/lib/utils"
@@ -43,6 +47,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -64,7 +69,7 @@ var _ = Describe("plugin", func() {
 			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
 		)
 		ctx = ctx.WithMultiStore(snapmulti.NewStoreFrom(ctx.MultiStore()))
-		p = utils.MustGetAs[*plugin](NewPlugin())
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, ethprecompile.Upgrades{}))
 		e = &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}}
 	})
 
@@ -97,6 +102,29 @@ var _ = Describe("plugin", func() {
 		Expect(cem.IsReadOnly()).To(BeFalse())
 	})
 
+	It("should run view methods in read-only mode", func() {
+		_, _, err := p.Run(e, &mockView{}, []byte{3}, addr2, new(big.Int), 5, false)
+		Expect(err.Error()).To(ContainSubstring(vm.ErrWriteProtection.Error()))
+		_, _, err = p.Run(e, &mockStateful{}, []byte{3}, addr2, new(big.Int), 5, false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should revert calls with value to non-payable methods since their upgrade", func() {
+		_, remainingGas, err := p.Run(e, &mockNonPayable{}, []byte{4}, addr2, big.NewInt(1), 5, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(4)))
+
+		p.upgrades = ethprecompile.Upgrades{NonPayableBlock: big.NewInt(0)}
+		_, remainingGas, err = p.Run(e, &mockNonPayable{}, []byte{4}, addr2, big.NewInt(1), 5, false)
+		Expect(errors.Is(err, vm.ErrExecutionReverted)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(ethprecompile.ErrNonPayableMethod.Error()))
+		Expect(remainingGas).To(Equal(uint64(5)))
+		_, _, err = p.Run(e, &mockNonPayable{}, []byte{4}, addr2, new(big.Int), 5, false)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = p.Run(e, &mockNonPayable{}, []byte{1}, addr2, big.NewInt(1), 5, false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should catch panics and return a geth error type", func() {
 		_, remainingGas, err := p.Run(e,
 			&mockPanicking{err: storetypes.ErrorNegativeGasConsumed{Descriptor: "henlo"}},
@@ -113,9 +141,310 @@ var _ = Describe("plugin", func() {
 	})
 })
 
+var _ = Describe("plugin gas schedules", func() {
+	var p *plugin
+	var e vm.PrecompileEVM
+	var ctx sdk.Context
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithEventManager(
+			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
+		)
+		ctx = ctx.WithMultiStore(snapmulti.NewStoreFrom(ctx.MultiStore()))
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, upgrades))
+		e = &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}}
+		Expect(p.Register(&mockScheduled{})).To(Succeed())
+	})
+
+	It("should not charge the gas schedules before their upgrade", func() {
+		p.upgrades = ethprecompile.Upgrades{GasSchedulesBlock: big.NewInt(10)}
+		e = &mockEVM{nil, ctx.WithBlockHeight(9), &mockSDB{nil, ctx, 0}}
+		_, remainingGas, err := p.Run(e, &mockScheduled{}, []byte{1, 2}, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(100)))
+
+		e = &mockEVM{nil, ctx.WithBlockHeight(10), &mockSDB{nil, ctx, 0}}
+		_, remainingGas, err = p.Run(e, &mockScheduled{}, []byte{1, 2}, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(91)))
+	})
+
+	It("should charge the gas schedule of the method", func() {
+		_, remainingGas, err := p.Run(e, &mockScheduled{}, []byte{1, 2}, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(91)))
+	})
+
+	It("should charge the base gas set by governance", func() {
+		Expect(p.SetMethodBaseGas(ctx, addr3, "foo", 50)).To(Succeed())
+		_, remainingGas, err := p.Run(e, &mockScheduled{}, []byte{1, 2}, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(48)))
+
+		_, _, err = p.Run(e, &mockScheduled{}, []byte{1, 2}, addr, new(big.Int), 51, false)
+		Expect(err).To(MatchError(vm.ErrOutOfGas))
+	})
+
+	It("should not set the base gas of unknown methods", func() {
+		Expect(p.SetMethodBaseGas(ctx, addr3, "bar", 50)).To(MatchError(ErrNoGasSchedule))
+		Expect(p.SetMethodBaseGas(ctx, addr2, "foo", 50)).To(MatchError(ErrPrecompileNotFound))
+	})
+})
+
+var _ = Describe("plugin activation schedules", func() {
+	var p *plugin
+	var ctx sdk.Context
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithEventManager(
+			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
+		)
+		ctx = ctx.WithMultiStore(snapmulti.NewStoreFrom(ctx.MultiStore()))
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, ethprecompile.Upgrades{}))
+		Expect(p.RegisterPrecompiles(
+			[]ethprecompile.Registrable{&mockStateless{}, &mockUpgraded{}},
+		)).To(Succeed())
+	})
+
+	It("should get every precompile when not bound to a block", func() {
+		_, found := p.Get(addr4, nil)
+		Expect(found).To(BeTrue())
+		Expect(p.GetActive(params.Rules{})).To(ConsistOf(addr, addr4))
+	})
+
+	It("should only get the precompiles active in the bound block", func() {
+		for _, tc := range []struct {
+			number uint64
+			time   uint64
+			active bool
+		}{
+			{9, 0, false},
+			{10, 0, true},
+			{20, 999, true},
+			{20, 1000, false},
+		} {
+			bound := p.AtBlock(new(big.Int).SetUint64(tc.number), tc.time)
+			_, found := bound.Get(addr4, nil)
+			Expect(found).To(Equal(tc.active))
+			Expect(bound.GetActive(params.Rules{})).To(ContainElement(addr))
+			Expect(slices.Contains(bound.GetActive(params.Rules{}), addr4)).To(Equal(tc.active))
+		}
+	})
+
+	It("should run an inactive precompile as an account without code", func() {
+		e := &mockBlockEVM{
+			mockEVM: &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}},
+			block:   vm.BlockContext{BlockNumber: big.NewInt(9)},
+		}
+		ret, remainingGas, err := p.Run(e, &mockUpgraded{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(BeNil())
+		Expect(remainingGas).To(Equal(uint64(30)))
+
+		e.block.BlockNumber = big.NewInt(10)
+		ret, remainingGas, err = p.Run(e, &mockUpgraded{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(Equal([]byte{4}))
+		Expect(remainingGas).To(Equal(uint64(20)))
+	})
+})
+
+var _ = Describe("plugin dynamic precompiles", func() {
+	var p *plugin
+	var e vm.PrecompileEVM
+	var ctx sdk.Context
+	var plf *mockLogFactory
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithEventManager(
+			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
+		)
+		ctx = ctx.WithMultiStore(snapmulti.NewStoreFrom(ctx.MultiStore()))
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, upgrades))
+		e = &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}}
+		plf = &mockLogFactory{}
+		p.SetPrecompileLogFactory(plf)
+		p.RegisterDynamicKind("mock", newMockDynamic)
+		Expect(p.RegisterPrecompiles([]ethprecompile.Registrable{&mockStateless{}})).To(Succeed())
+	})
+
+	It("should instantiate dynamic precompiles at derived addresses", func() {
+		pcAddr, err := p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pcAddr).To(Equal(DynamicPrecompileAddress("mock", "abera")))
+		Expect(plf.registered).To(Equal([]common.Address{pcAddr}))
+
+		// only a plugin bound to a context gets the dynamic precompiles
+		_, found := p.Get(pcAddr, nil)
+		Expect(found).To(BeFalse())
+		bound := p.WithContext(ctx)
+		_, found = bound.Get(pcAddr, nil)
+		Expect(found).To(BeTrue())
+		Expect(bound.GetActive(params.Rules{})).To(ConsistOf(addr, pcAddr))
+
+		_, err = p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).To(MatchError(ErrPrecompileExists))
+		_, err = p.InstantiatePrecompile(ctx, "unknown", "abera")
+		Expect(err).To(MatchError(ErrUnknownDynamicKind))
+		_, err = p.InstantiatePrecompile(ctx, "mock", "")
+		Expect(err).To(MatchError(errInvalidMockArgs))
+	})
+
+	It("should revert calls to paused dynamic precompiles", func() {
+		pcAddr, err := p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+		pc, found := p.WithContext(ctx).Get(pcAddr, nil)
+		Expect(found).To(BeTrue())
+		input := mockDynamicABI.Methods["denom"].ID
+
+		ret, _, err := p.Run(e, pc, input, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		denom, err := mockDynamicABI.Unpack("denom", ret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(denom).To(Equal([]any{"abera"}))
+
+		Expect(p.SetPrecompilePaused(ctx, pcAddr, true)).To(Succeed())
+		_, remainingGas, err := p.Run(e, pc, input, addr, new(big.Int), 100, false)
+		Expect(errors.Is(err, vm.ErrExecutionReverted)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(ErrPrecompilePaused.Error()))
+		Expect(remainingGas).To(Equal(uint64(100)))
+
+		Expect(p.SetPrecompilePaused(ctx, pcAddr, false)).To(Succeed())
+		_, _, err = p.Run(e, pc, input, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should remove dynamic precompiles", func() {
+		pcAddr, err := p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.RemovePrecompile(ctx, pcAddr)).To(Succeed())
+
+		_, found := p.WithContext(ctx).Get(pcAddr, nil)
+		Expect(found).To(BeFalse())
+		Expect(p.WithContext(ctx).GetActive(params.Rules{})).To(Equal([]common.Address{addr}))
+		Expect(p.RemovePrecompile(ctx, pcAddr)).To(MatchError(ErrPrecompileNotFound))
+		Expect(p.SetPrecompilePaused(ctx, pcAddr, true)).To(MatchError(ErrPrecompileNotFound))
+
+		// a removed precompile can be instantiated again
+		_, err = p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should set the base gas of dynamic precompiles", func() {
+		pcAddr, err := p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+		pc, _ := p.WithContext(ctx).Get(pcAddr, nil)
+		input := mockDynamicABI.Methods["denom"].ID
+
+		_, remainingGas, err := p.Run(e, pc, input, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(90)))
+
+		Expect(p.SetMethodBaseGas(ctx, pcAddr, "denom", 30)).To(Succeed())
+		_, remainingGas, err = p.Run(e, pc, input, addr, new(big.Int), 100, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(70)))
+	})
+
+	It("should index the instances of later blocks", func() {
+		// the index is loaded from the block of the context
+		_, found := p.WithContext(ctx).Get(DynamicPrecompileAddress("mock", "abera"), nil)
+		Expect(found).To(BeFalse())
+
+		// instantiate with another plugin, as another node did before this one started
+		other := utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, upgrades))
+		other.RegisterDynamicKind("mock", newMockDynamic)
+		pcAddr, err := other.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+
+		next := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, found = p.WithContext(next).Get(pcAddr, nil)
+		Expect(found).To(BeTrue())
+		Expect(p.WithContext(next).GetActive(params.Rules{})).To(ConsistOf(addr, pcAddr))
+	})
+
+	It("should export and import the precompile state", func() {
+		pcAddr, err := p.InstantiatePrecompile(ctx, "mock", "abera")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.SetPrecompilePaused(ctx, pcAddr, true)).To(Succeed())
+		Expect(p.SetMethodBaseGas(ctx, pcAddr, "denom", 30)).To(Succeed())
+
+		pg, err := p.ExportPrecompileGenesis(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pg).To(Equal(&types.PrecompileGenesis{
+			Dynamic: []types.DynamicPrecompile{
+				{Address: pcAddr, Kind: "mock", Args: "abera", Paused: true},
+			},
+			MethodBaseGas: []types.MethodBaseGas{
+				{Precompile: pcAddr, Method: "denom", BaseGas: 30},
+			},
+		}))
+
+		newCtx := testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		newP := utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, upgrades))
+		newP.RegisterDynamicKind("mock", newMockDynamic)
+		Expect(newP.InitPrecompileGenesis(newCtx, pg)).To(Succeed())
+		Expect(newP.ExportPrecompileGenesis(newCtx)).To(Equal(pg))
+		_, found := newP.WithContext(newCtx).Get(pcAddr, nil)
+		Expect(found).To(BeTrue())
+		Expect(newP.isPaused(newCtx, pcAddr)).To(BeTrue())
+
+		pg.Dynamic[0].Address = common.Address{0xff}
+		Expect(newP.InitPrecompileGenesis(newCtx, pg)).To(MatchError(ErrInvalidDynamicAddress))
+	})
+})
+
+var _ = Describe("plugin tracing", func() {
+	var p *plugin
+	var ctx sdk.Context
+	var tracer *mockTracer
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithEventManager(
+			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
+		)
+		ctx = ctx.WithMultiStore(snapmulti.NewStoreFrom(ctx.MultiStore()))
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, ethprecompile.Upgrades{}))
+		tracer = &mockTracer{}
+	})
+
+	It("should report the method and the Cosmos effects of precompiles to tracers", func() {
+		e := &vm.EVM{StateDB: &mockSDB{nil, ctx, 0}, Config: vm.Config{Tracer: tracer}}
+		_, _, err := p.Run(e, &mockTraced{}, []byte{1}, addr, new(big.Int), 100000, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(tracer.method).To(Equal("trace"))
+		Expect(tracer.args).To(Equal([]any{uint8(1)}))
+		Expect(tracer.reads).To(Equal([]string{"evm/a=1", "evm/b=", "evm/a=1"}))
+		Expect(tracer.writes).To(Equal([]string{"evm/a=1", "evm/b=2", "evm/b="}))
+		Expect(tracer.events).To(Equal([]string{"traced"}))
+
+		// the effects are still made on the context of the EVM
+		Expect(ctx.KVStore(testutil.EvmKey).Get([]byte("a"))).To(Equal([]byte("1")))
+		Expect(ctx.EventManager().Events()).To(HaveLen(1))
+	})
+
+	It("should not trace without a tracer of Cosmos effects", func() {
+		e := &vm.EVM{StateDB: &mockSDB{nil, ctx, 0}}
+		_, _, err := p.Run(e, &mockTraced{}, []byte{1}, addr, new(big.Int), 100000, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tracer.method).To(BeEmpty())
+		Expect(ctx.KVStore(testutil.EvmKey).Get([]byte("a"))).To(Equal([]byte("1")))
+	})
+})
+
 var (
+	// upgrades activates every upgrade of the stateful precompiles since genesis.
+	upgrades = ethprecompile.Upgrades{GasSchedulesBlock: big.NewInt(0)}
+
 	addr  = common.BytesToAddress([]byte{1})
 	addr2 = common.BytesToAddress([]byte{2})
+	addr3 = common.BytesToAddress([]byte{3})
+	addr4 = common.BytesToAddress([]byte{4})
 )
 
 type mockEVM struct {
@@ -128,6 +457,10 @@ func (me *mockEVM) GetStateDB() vm.StateDB {
 	return me.ms
 }
 
+func (me *mockEVM) GetContext() *vm.BlockContext {
+	return &vm.BlockContext{BlockNumber: big.NewInt(me.ctx.BlockHeight())}
+}
+
 type mockSDB struct {
 	pvm.PolarStateDB
 	ctx  sdk.Context
@@ -190,6 +523,22 @@ func (msf *mockStateful) RequiredGas(_ []byte) uint64 {
 	return 1
 }
 
+type mockView struct {
+	mockStateful
+}
+
+func (mv *mockView) IsReadOnly(input []byte) bool {
+	return input[0] == byte(3)
+}
+
+type mockNonPayable struct {
+	mockStateful
+}
+
+func (mnp *mockNonPayable) IsPayable(input []byte) bool {
+	return input[0] != byte(4)
+}
+
 type mockPanicking struct {
 	err any
 } // at addr 1
@@ -208,3 +557,168 @@ func (mp *mockPanicking) Run(
 func (*mockPanicking) RequiredGas(_ []byte) uint64 {
 	return 1
 }
+
+type mockBlockEVM struct {
+	*mockEVM
+	block vm.BlockContext
+}
+
+func (me *mockBlockEVM) GetContext() *vm.BlockContext {
+	return &me.block
+}
+
+type mockUpgraded struct{} // at addr 4
+
+func (mu *mockUpgraded) RegistryKey() common.Address {
+	return addr4
+}
+
+func (mu *mockUpgraded) Run(
+	_ context.Context, _ vm.PrecompileEVM, _ []byte,
+	_ common.Address, _ *big.Int,
+) ([]byte, error) {
+	return []byte{4}, nil
+}
+
+func (mu *mockUpgraded) RequiredGas(_ []byte) uint64 {
+	return 10
+}
+
+func (mu *mockUpgraded) ActivationSchedule() ethprecompile.Schedule {
+	return ethprecompile.Schedule{
+		Activation:   ethprecompile.BlockActivation(10),
+		Deactivation: ethprecompile.TimeActivation(1000),
+	}
+}
+
+type mockScheduled struct{} // at addr 3
+
+func (ms *mockScheduled) RegistryKey() common.Address {
+	return addr3
+}
+
+func (ms *mockScheduled) Run(
+	_ context.Context, _ vm.PrecompileEVM, _ []byte,
+	_ common.Address, _ *big.Int,
+) ([]byte, error) {
+	return nil, nil
+}
+
+func (ms *mockScheduled) RequiredGas(input []byte) uint64 {
+	_, gas, _ := ms.MethodGas(input)
+	return gas.RequiredGas(input)
+}
+
+func (ms *mockScheduled) MethodGas(_ []byte) (string, ethprecompile.MethodGas, bool) {
+	return "foo", ethprecompile.MethodGas{
+		Base:    7,
+		Dynamic: func(input []byte) uint64 { return uint64(len(input)) },
+	}, true
+}
+
+func (ms *mockScheduled) HasMethod(name string) bool {
+	return name == "foo"
+}
+
+const mockDynamicABIJSON = `[{"type":"function","name":"denom","inputs":[],` +
+	`"outputs":[{"name":"","type":"string"}],"stateMutability":"view"}]`
+
+var (
+	mockDynamicABI     = abi.MustUnmarshalJSON(mockDynamicABIJSON)
+	errInvalidMockArgs = errors.New("invalid mock args")
+)
+
+type mockDynamic struct {
+	ethprecompile.BaseContract
+	args string
+}
+
+func newMockDynamic(addr common.Address, args string) (ethprecompile.DynamicImpl, error) {
+	if args == "" {
+		return nil, errInvalidMockArgs
+	}
+	return &mockDynamic{
+		BaseContract: ethprecompile.NewBaseContract(mockDynamicABIJSON, addr),
+		args:         args,
+	}, nil
+}
+
+func (md *mockDynamic) Name() string {
+	return "mock/" + md.args
+}
+
+func (md *mockDynamic) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{"denom": {Base: 10}}
+}
+
+func (md *mockDynamic) Denom(context.Context) (string, error) {
+	return md.args, nil
+}
+
+type mockLogFactory struct {
+	registered []common.Address
+}
+
+func (mlf *mockLogFactory) RegisterInstance(pc ethprecompile.DynamicImpl) {
+	mlf.registered = append(mlf.registered, pc.RegistryKey())
+}
+
+type mockTraced struct{} // at addr 1
+
+func (mt *mockTraced) RegistryKey() common.Address {
+	return addr
+}
+
+func (mt *mockTraced) Run(
+	ctx context.Context, _ vm.PrecompileEVM, _ []byte,
+	_ common.Address, _ *big.Int,
+) ([]byte, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(testutil.EvmKey)
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	store.Delete([]byte("b"))
+	_ = store.Get([]byte("a"))
+	_ = store.Get([]byte("b"))
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		_ = iter.Value()
+	}
+	_ = iter.Close()
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("traced"))
+	return nil, nil
+}
+
+func (mt *mockTraced) RequiredGas(_ []byte) uint64 {
+	return 1
+}
+
+func (mt *mockTraced) DecodeInput(input []byte) (string, []any, bool) {
+	return "trace", []any{input[0]}, true
+}
+
+type mockTracer struct {
+	vm.EVMLogger
+	method string
+	args   []any
+	reads  []string
+	writes []string
+	events []string
+}
+
+func (mt *mockTracer) CapturePrecompileStart(_ common.Address, method string, args []any) {
+	mt.method = method
+	mt.args = args
+}
+
+func (mt *mockTracer) CaptureKVRead(store string, key, value []byte) {
+	mt.reads = append(mt.reads, store+"/"+string(key)+"="+string(value))
+}
+
+func (mt *mockTracer) CaptureKVWrite(store string, key, value []byte) {
+	mt.writes = append(mt.writes, store+"/"+string(key)+"="+string(value))
+}
+
+func (mt *mockTracer) CaptureCosmosEvent(event sdk.Event) {
+	mt.events = append(mt.events, event.Type)
+}
@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/precompile"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// Name is the name of the cosmosTracer in the geth tracer registry.
+const Name = "cosmosTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(Name, newCosmosTracer, false)
+}
+
+// cosmosTracer is a native geth tracer, which traces the call frames of a transaction as the
+// `callTracer` does, and the Cosmos effects of the stateful precompiles in their call frames: the
+// decoded method and arguments, the KV reads and writes per store, the emitted Cosmos events, and
+// the EVM frames that the precompiles call into.
+type cosmosTracer struct {
+	callstack []*callFrame
+	gasLimit  uint64
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+var _ precompile.Tracer = (*cosmosTracer)(nil)
+
+// newCosmosTracer returns a new cosmosTracer, which takes no config.
+func newCosmosTracer(*tracers.Context, json.RawMessage) (tracers.Tracer, error) {
+	// First callframe contains tx context info and is populated on start and end.
+	return &cosmosTracer{callstack: []*callFrame{{}}}, nil
+}
+
+// CaptureTxStart implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+// CaptureTxEnd implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureTxEnd(restGas uint64) {
+	t.callstack[0].GasUsed = hexutil.Uint64(t.gasLimit - restGas)
+}
+
+// CaptureStart implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureStart(
+	_ *vm.EVM, from, to common.Address, create bool, input []byte, _ uint64, value *big.Int,
+) {
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.callstack[0] = newCallFrame(typ, from, to, input, t.gasLimit, value)
+}
+
+// CaptureEnd implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureEnd(output []byte, _ uint64, err error) {
+	t.callstack[0].processOutput(output, err)
+}
+
+// CaptureEnter implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureEnter(
+	typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int,
+) {
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+	t.callstack = append(t.callstack, newCallFrame(typ, from, to, input, gas, value))
+}
+
+// CaptureExit implements vm.EVMLogger.
+func (t *cosmosTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	// pop call
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+
+	call.GasUsed = hexutil.Uint64(gasUsed)
+	call.processOutput(output, err)
+	parent := t.callstack[size-2]
+	parent.Calls = append(parent.Calls, call)
+}
+
+// CaptureState implements vm.EVMLogger.
+func (*cosmosTracer) CaptureState(
+	uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error,
+) {
+}
+
+// CaptureFault implements vm.EVMLogger.
+func (*cosmosTracer) CaptureFault(
+	uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error,
+) {
+}
+
+// CapturePrecompileStart implements precompile.Tracer.
+func (t *cosmosTracer) CapturePrecompileStart(_ common.Address, method string, args []any) {
+	t.current().Precompile = &precompileFrame{
+		Method: method,
+		Args:   args,
+		Stores: make(map[string]*storeAccesses),
+	}
+}
+
+// CaptureKVRead implements precompile.Tracer.
+func (t *cosmosTracer) CaptureKVRead(store string, key, value []byte) {
+	if sa := t.storeAccesses(store); sa != nil {
+		sa.Reads = append(sa.Reads, t.kvAccess(key, value))
+	}
+}
+
+// CaptureKVWrite implements precompile.Tracer.
+func (t *cosmosTracer) CaptureKVWrite(store string, key, value []byte) {
+	if sa := t.storeAccesses(store); sa != nil {
+		sa.Writes = append(sa.Writes, t.kvAccess(key, value))
+	}
+}
+
+// CaptureCosmosEvent implements precompile.Tracer.
+func (t *cosmosTracer) CaptureCosmosEvent(event sdk.Event) {
+	call := t.current()
+	if call.Precompile == nil {
+		return
+	}
+	ce := cosmosEvent{Type: event.Type, Position: t.position()}
+	for _, attr := range event.Attributes {
+		ce.Attributes = append(ce.Attributes, eventAttribute{Key: attr.Key, Value: attr.Value})
+	}
+	call.Precompile.Events = append(call.Precompile.Events, ce)
+}
+
+// GetResult returns the json-encoded nested list of call traces, and any error arising from the
+// encoding or forceful termination (via `Stop`).
+//
+// GetResult implements tracers.Tracer.
+func (t *cosmosTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+//
+// Stop implements tracers.Tracer.
+func (t *cosmosTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// current returns the call frame that is currently executing.
+func (t *cosmosTracer) current() *callFrame {
+	return t.callstack[len(t.callstack)-1]
+}
+
+// position returns the position of the next Cosmos effect of the current call frame relative to
+// the EVM frames that it calls into.
+func (t *cosmosTracer) position() hexutil.Uint {
+	return hexutil.Uint(len(t.current().Calls))
+}
+
+// storeAccesses returns the accesses of the current precompile to the store with the given name,
+// or nil if the current call frame is not a precompile.
+func (t *cosmosTracer) storeAccesses(store string) *storeAccesses {
+	pf := t.current().Precompile
+	if pf == nil {
+		return nil
+	}
+	sa, found := pf.Stores[store]
+	if !found {
+		sa = &storeAccesses{}
+		pf.Stores[store] = sa
+	}
+	return sa
+}
+
+// kvAccess returns the access to the given key and value at the current position.
+func (t *cosmosTracer) kvAccess(key, value []byte) kvAccess {
+	access := kvAccess{Key: common.CopyBytes(key), Position: t.position()}
+	if value != nil {
+		v := hexutil.Bytes(common.CopyBytes(value))
+		access.Value = &v
+	}
+	return access
+}
+
+// callFrame is a call frame of the trace, which also holds the Cosmos effects of a precompile.
+type callFrame struct {
+	Type         string           `json:"type"`
+	From         common.Address   `json:"from"`
+	To           *common.Address  `json:"to,omitempty"`
+	Value        *hexutil.Big     `json:"value,omitempty"`
+	Gas          hexutil.Uint64   `json:"gas"`
+	GasUsed      hexutil.Uint64   `json:"gasUsed"`
+	Input        hexutil.Bytes    `json:"input"`
+	Output       hexutil.Bytes    `json:"output,omitempty"`
+	Error        string           `json:"error,omitempty"`
+	RevertReason string           `json:"revertReason,omitempty"`
+	Precompile   *precompileFrame `json:"precompile,omitempty"`
+	Calls        []*callFrame     `json:"calls,omitempty"`
+}
+
+// newCallFrame returns a new call frame of the given type.
+func newCallFrame(
+	typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int,
+) *callFrame {
+	cf := &callFrame{
+		Type:  typ.String(),
+		From:  from,
+		To:    &to,
+		Gas:   hexutil.Uint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if value != nil {
+		cf.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	return cf
+}
+
+// processOutput sets the output of the call frame, and its error and revert reason if it failed.
+func (f *callFrame) processOutput(output []byte, err error) {
+	output = common.CopyBytes(output)
+	if err == nil {
+		f.Output = output
+		return
+	}
+	f.Error = err.Error()
+	if f.Type == vm.CREATE.String() || f.Type == vm.CREATE2.String() {
+		f.To = nil
+	}
+	if !errors.Is(err, vm.ErrExecutionReverted) || len(output) == 0 {
+		return
+	}
+	f.Output = output
+	if reason, unpackErr := abi.UnpackRevert(output); unpackErr == nil {
+		f.RevertReason = reason
+	}
+}
+
+// precompileFrame holds the Cosmos effects of a stateful precompile in its call frame. The
+// positions of the effects are the number of EVM frames that the precompile called into before.
+type precompileFrame struct {
+	Method string                    `json:"method"`
+	Args   []any                     `json:"args,omitempty"`
+	Stores map[string]*storeAccesses `json:"stores,omitempty"`
+	Events []cosmosEvent             `json:"events,omitempty"`
+}
+
+// storeAccesses are the KV reads and writes of a precompile to a store.
+type storeAccesses struct {
+	Reads  []kvAccess `json:"reads,omitempty"`
+	Writes []kvAccess `json:"writes,omitempty"`
+}
+
+// kvAccess is a KV read or write of a precompile. The value of a deleted key is null.
+type kvAccess struct {
+	Key      hexutil.Bytes  `json:"key"`
+	Value    *hexutil.Bytes `json:"value"`
+	Position hexutil.Uint   `json:"position"`
+}
+
+// cosmosEvent is a Cosmos event emitted by a precompile.
+type cosmosEvent struct {
+	Type       string           `json:"type"`
+	Attributes []eventAttribute `json:"attributes,omitempty"`
+	Position   hexutil.Uint     `json:"position"`
+}
+
+// eventAttribute is an attribute of a Cosmos event.
+type eventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
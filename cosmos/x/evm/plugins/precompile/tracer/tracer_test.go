@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTracer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/plugins/precompile/tracer")
+}
+
+var _ = Describe("cosmosTracer", func() {
+	var (
+		t          tracers.Tracer
+		ct         *cosmosTracer
+		sender     = common.BytesToAddress([]byte{1})
+		contract   = common.BytesToAddress([]byte{2})
+		precompile = common.BytesToAddress([]byte{3})
+	)
+
+	BeforeEach(func() {
+		var err error
+		t, err = tracers.DefaultDirectory.New(Name, &tracers.Context{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		ct = t.(*cosmosTracer)
+	})
+
+	It("should trace the Cosmos effects of precompiles in their call frames", func() {
+		ct.CaptureTxStart(100000)
+		ct.CaptureStart(nil, sender, contract, false, []byte{1}, 100000, big.NewInt(0))
+		ct.CaptureEnter(vm.CALL, contract, precompile, []byte{2}, 50000, nil)
+		ct.CapturePrecompileStart(precompile, "delegate", []any{big.NewInt(7)})
+		ct.CaptureKVRead("staking", []byte{0xa}, nil)
+		ct.CaptureKVWrite("bank", []byte{0xb}, []byte{0xc})
+		ct.CaptureCosmosEvent(sdk.NewEvent("delegate", sdk.NewAttribute("amount", "7")))
+		// the precompile calls back into the EVM
+		ct.CaptureEnter(vm.CALL, precompile, contract, []byte{3}, 10000, big.NewInt(0))
+		ct.CaptureExit(nil, 100, errors.New("boom"))
+		ct.CaptureKVWrite("bank", []byte{0xb}, nil)
+		ct.CaptureExit([]byte{4}, 20000, nil)
+		ct.CaptureEnd(nil, 30000, nil)
+		ct.CaptureTxEnd(60000)
+
+		res, err := t.GetResult()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(MatchJSON(`{
+			"type": "CALL",
+			"from": "0x0000000000000000000000000000000000000001",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0x0",
+			"gas": "0x186a0",
+			"gasUsed": "0x9c40",
+			"input": "0x01",
+			"calls": [{
+				"type": "CALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"gas": "0xc350",
+				"gasUsed": "0x4e20",
+				"input": "0x02",
+				"output": "0x04",
+				"precompile": {
+					"method": "delegate",
+					"args": [7],
+					"stores": {
+						"bank": {"writes": [
+							{"key": "0x0b", "value": "0x0c", "position": "0x0"},
+							{"key": "0x0b", "value": null, "position": "0x1"}
+						]},
+						"staking": {"reads": [{"key": "0x0a", "value": null, "position": "0x0"}]}
+					},
+					"events": [{
+						"type": "delegate",
+						"attributes": [{"key": "amount", "value": "7"}],
+						"position": "0x0"
+					}]
+				},
+				"calls": [{
+					"type": "CALL",
+					"from": "0x0000000000000000000000000000000000000003",
+					"to": "0x0000000000000000000000000000000000000002",
+					"value": "0x0",
+					"gas": "0x2710",
+					"gasUsed": "0x64",
+					"input": "0x03",
+					"error": "boom"
+				}]
+			}]
+		}`))
+	})
+
+	It("should ignore Cosmos effects outside of precompiles", func() {
+		ct.CaptureStart(nil, sender, contract, false, nil, 0, nil)
+		ct.CaptureKVRead("bank", []byte{0xa}, []byte{0xb})
+		ct.CaptureCosmosEvent(sdk.NewEvent("transfer"))
+		ct.CaptureEnd(nil, 0, nil)
+
+		res, err := t.GetResult()
+		Expect(err).ToNot(HaveOccurred())
+		var frame map[string]any
+		Expect(json.Unmarshal(res, &frame)).To(Succeed())
+		Expect(frame).ToNot(HaveKey("precompile"))
+	})
+
+	It("should return the reason it was stopped", func() {
+		ct.CaptureStart(nil, sender, contract, false, nil, 0, nil)
+		t.Stop(errors.New("timeout"))
+		ct.CaptureEnter(vm.CALL, contract, precompile, nil, 0, nil)
+		ct.CaptureEnd(nil, 0, nil)
+
+		_, err := t.GetResult()
+		Expect(err).To(MatchError("timeout"))
+	})
+})
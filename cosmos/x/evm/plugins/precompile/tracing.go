@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/lib/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Tracer is the tracing hook of the plugin, which is implemented by the geth tracers that also
+// trace the Cosmos effects of the stateful precompiles. The EVM opens the call frame of a
+// precompile before the plugin runs it, so the hook is called within the frame of the running
+// precompile, and the EVM frames that the precompile calls into are nested in it.
+type Tracer interface {
+	vm.EVMLogger
+
+	// CapturePrecompileStart is called when the precompile at the given address starts running
+	// the method decoded from its input, with the arguments unpacked from the input.
+	CapturePrecompileStart(precompile common.Address, method string, args []any)
+	// CaptureKVRead is called when the precompile reads the value of the given key from the
+	// store with the given name. The value is nil if the key is not set.
+	CaptureKVRead(store string, key, value []byte)
+	// CaptureKVWrite is called when the precompile writes the value of the given key to the
+	// store with the given name. The value is nil if the key is deleted.
+	CaptureKVWrite(store string, key, value []byte)
+	// CaptureCosmosEvent is called when the precompile emits the given Cosmos event.
+	CaptureCosmosEvent(event sdk.Event)
+}
+
+// tracerOf returns the tracer of the given EVM, if it traces the Cosmos effects of precompiles.
+func tracerOf(evm vm.PrecompileEVM) (Tracer, bool) {
+	e, ok := utils.GetAs[*vm.EVM](evm)
+	if !ok || e.Config.Tracer == nil {
+		return nil, false
+	}
+	return utils.GetAs[Tracer](e.Config.Tracer)
+}
+
+// traceContext reports the method of the given precompile input to the tracer, and returns the
+// context to run the precompile with, whose stores and event manager report the Cosmos effects
+// of the precompile to the tracer.
+func traceContext(
+	ctx sdk.Context, tracer Tracer, pc vm.PrecompiledContract, addr common.Address, input []byte,
+) sdk.Context {
+	var (
+		method string
+		args   []any
+	)
+	if dc, ok := utils.GetAs[ethprecompile.DecodingContract](pc); ok {
+		method, args, _ = dc.DecodeInput(input)
+	}
+	tracer.CapturePrecompileStart(addr, method, args)
+
+	return ctx.
+		WithMultiStore(&tracedMultiStore{
+			MultiStore: utils.MustGetAs[MultiStore](ctx.MultiStore()),
+			tracer:     tracer,
+		}).
+		WithEventManager(&tracedEventManager{
+			ControllableEventManager: utils.MustGetAs[state.ControllableEventManager](
+				ctx.EventManager(),
+			),
+			tracer: tracer,
+		})
+}
+
+// tracedMultiStore is a MultiStore, whose KV stores report their reads and writes to a tracer.
+type tracedMultiStore struct {
+	MultiStore
+	tracer Tracer
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *tracedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &tracedKVStore{
+		KVStore: ms.MultiStore.GetKVStore(key),
+		name:    key.Name(),
+		tracer:  ms.tracer,
+	}
+}
+
+// CacheMultiStore implements storetypes.MultiStore. The KV stores of the cache also report their
+// reads and writes, which are reported when they are made, even if the cache is not written.
+func (ms *tracedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return &tracedCacheMultiStore{
+		cacheMultiStore: ms.MultiStore.CacheMultiStore(),
+		tracer:          ms.tracer,
+	}
+}
+
+// tracedCacheMultiStore is a CacheMultiStore, whose KV stores report their reads and writes to a
+// tracer.
+type tracedCacheMultiStore struct {
+	cacheMultiStore
+	tracer Tracer
+}
+
+// cacheMultiStore is embedded by tracedCacheMultiStore, as the field of an embedded
+// storetypes.CacheMultiStore would shadow its CacheMultiStore method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *tracedCacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &tracedKVStore{
+		KVStore: ms.cacheMultiStore.GetKVStore(key),
+		name:    key.Name(),
+		tracer:  ms.tracer,
+	}
+}
+
+// tracedKVStore is a KVStore, which reports its reads and writes to a tracer.
+type tracedKVStore struct {
+	storetypes.KVStore
+	name   string
+	tracer Tracer
+}
+
+// Get implements storetypes.KVStore.
+func (s *tracedKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.tracer.CaptureKVRead(s.name, key, value)
+	return value
+}
+
+// Set implements storetypes.KVStore.
+func (s *tracedKVStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.tracer.CaptureKVWrite(s.name, key, value)
+}
+
+// Delete implements storetypes.KVStore.
+func (s *tracedKVStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.tracer.CaptureKVWrite(s.name, key, nil)
+}
+
+// Iterator implements storetypes.KVStore.
+func (s *tracedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newTracedIterator(s.KVStore.Iterator(start, end), s)
+}
+
+// ReverseIterator implements storetypes.KVStore.
+func (s *tracedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newTracedIterator(s.KVStore.ReverseIterator(start, end), s)
+}
+
+// tracedIterator is an Iterator, which reports the entries that it visits to a tracer.
+type tracedIterator struct {
+	storetypes.Iterator
+	store *tracedKVStore
+}
+
+// newTracedIterator returns the traced iterator, which reports its first entry, if any.
+func newTracedIterator(iter storetypes.Iterator, store *tracedKVStore) *tracedIterator {
+	ti := &tracedIterator{Iterator: iter, store: store}
+	ti.capture()
+	return ti
+}
+
+// Next implements storetypes.Iterator.
+func (it *tracedIterator) Next() {
+	it.Iterator.Next()
+	it.capture()
+}
+
+// capture reports the entry that the iterator is at, if it is valid.
+func (it *tracedIterator) capture() {
+	if it.Iterator.Valid() {
+		it.store.tracer.CaptureKVRead(it.store.name, it.Iterator.Key(), it.Iterator.Value())
+	}
+}
+
+// tracedEventManager is a ControllableEventManager, which reports the Cosmos events that are
+// emitted to a tracer.
+type tracedEventManager struct {
+	state.ControllableEventManager
+	tracer Tracer
+}
+
+// EmitEvent implements sdk.EventManagerI.
+func (em *tracedEventManager) EmitEvent(event sdk.Event) {
+	em.ControllableEventManager.EmitEvent(event)
+	em.tracer.CaptureCosmosEvent(event)
+}
+
+// EmitEvents implements sdk.EventManagerI.
+func (em *tracedEventManager) EmitEvents(events sdk.Events) {
+	em.ControllableEventManager.EmitEvents(events)
+	for _, event := range events {
+		em.tracer.CaptureCosmosEvent(event)
+	}
+}
+
+// EmitTypedEvent implements sdk.EventManagerI.
+func (em *tracedEventManager) EmitTypedEvent(tev proto.Message) error {
+	if err := em.ControllableEventManager.EmitTypedEvent(tev); err != nil {
+		return err
+	}
+	event, err := sdk.TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+	em.tracer.CaptureCosmosEvent(event)
+	return nil
+}
+
+// EmitTypedEvents implements sdk.EventManagerI.
+func (em *tracedEventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	for _, tev := range tevs {
+		if err := em.EmitTypedEvent(tev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
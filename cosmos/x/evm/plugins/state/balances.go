@@ -0,0 +1,352 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// evmDecimals is the number of decimals of the native value of the EVM, i.e. wei per ether.
+const evmDecimals = 18
+
+var (
+	// ErrNegativeBalance is returned when a balance backend is asked to set a negative balance.
+	ErrNegativeBalance = errors.New("balance is negative")
+	// ErrInvalidDecimals is returned when the bank denom has more decimals than the EVM.
+	ErrInvalidDecimals = errors.New("decimals of the bank denom are above 18")
+)
+
+// Balances is the backend of the state plugin that holds the native balances of the EVM
+// accounts. It is always given the context of the state plugin, so that every write it makes
+// goes through the snapshottable multi-store and is reverted along with the rest of the state.
+type Balances interface {
+	// GetBalance returns the balance, in wei, of the given account.
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
+	// SetBalance sets the balance, in wei, of the given account.
+	SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error
+	// IterateBalances calls fn with every account that holds a balance, in ascending order of
+	// address starting at the given address, until fn returns true.
+	IterateBalances(
+		ctx sdk.Context, start common.Address, fn func(common.Address, *big.Int) bool,
+	) error
+}
+
+// =============================================================================
+// Store Balances
+// =============================================================================
+
+// storeBalances keeps the balances under `BalanceKeyPrefix` in the evm store.
+type storeBalances struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewStoreBalances returns the default balance backend, which keeps the balances in the evm
+// store, separately from x/bank.
+func NewStoreBalances(storeKey storetypes.StoreKey) Balances {
+	return &storeBalances{storeKey: storeKey}
+}
+
+// GetBalance implements `Balances`.
+func (sb *storeBalances) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(ctx.MultiStore().GetKVStore(sb.storeKey).Get(BalanceKeyFor(addr)))
+}
+
+// SetBalance implements `Balances`.
+func (sb *storeBalances) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	ctx.MultiStore().GetKVStore(sb.storeKey).Set(BalanceKeyFor(addr), amount.Bytes())
+	return nil
+}
+
+// IterateBalances implements `Balances`.
+func (sb *storeBalances) IterateBalances(
+	ctx sdk.Context, start common.Address, fn func(common.Address, *big.Int) bool,
+) error {
+	it := ctx.MultiStore().GetKVStore(sb.storeKey).Iterator(
+		BalanceKeyFor(start), storetypes.PrefixEndBytes([]byte{types.BalanceKeyPrefix}),
+	)
+	for ; it.Valid(); it.Next() {
+		if fn(AddressFromBalanceKey(it.Key()), new(big.Int).SetBytes(it.Value())) {
+			break
+		}
+	}
+	return it.Close()
+}
+
+// =============================================================================
+// Bank Balances
+// =============================================================================
+
+// BankBalancesConfig configures the bank denom that backs the native value of the EVM.
+type BankBalancesConfig struct {
+	// Denom is the bank denom of the native value of the EVM.
+	Denom string
+	// Decimals is the number of decimals of the denom. One unit of the denom is worth
+	// 10^(18-Decimals) wei, so that a denom with 18 decimals maps one to one onto wei.
+	Decimals uint8
+}
+
+// bankBalances keeps the balances in x/bank, in the configured denom.
+type bankBalances struct {
+	bk       BankKeeper
+	denoms   DenomIndex
+	storeKey storetypes.StoreKey
+	denom    string
+	// factor is the number of wei in one unit of the denom.
+	factor *big.Int
+	// reserve is the address of the evm module account, which holds the reserve.
+	reserve sdk.AccAddress
+}
+
+// NewBankBalances returns a balance backend that keeps the balances in x/bank, so that the
+// native value of the EVM and the configured bank denom are one and the same.
+//
+// The whole units of the denom in a balance are held by the account in x/bank and are minted or
+// burned as the EVM credits or debits the account. The remainder below one unit cannot be held
+// in x/bank and is kept in the evm store instead. To keep the total supply of the denom covering
+// every wei in the EVM, the evm module account holds a reserve of at least as many units as
+// needed to back the sum of these remainders. The reserve shows up on the EVM as the balance of
+// the evm module account, which must have the minter and burner permissions.
+//
+// The balances are iterated with the given index of the bank balances by denom, so that only the
+// balances of the configured denom are read.
+//
+// NOTE: the bank events of the EVM value transfers are not emitted, as the transfers are already
+// observable on the EVM.
+func NewBankBalances(
+	bk BankKeeper, denoms DenomIndex, storeKey storetypes.StoreKey, cfg BankBalancesConfig,
+) (Balances, error) {
+	if cfg.Decimals > evmDecimals {
+		return nil, ErrInvalidDecimals
+	}
+	if err := sdk.ValidateDenom(cfg.Denom); err != nil {
+		return nil, errorslib.Wrap(err, "invalid bank denom")
+	}
+	return &bankBalances{
+		bk:       bk,
+		denoms:   denoms,
+		storeKey: storeKey,
+		denom:    cfg.Denom,
+		factor: new(big.Int).Exp(
+			big.NewInt(10), big.NewInt(int64(evmDecimals-cfg.Decimals)), nil,
+		),
+		reserve: authtypes.NewModuleAddress(types.ModuleName),
+	}, nil
+}
+
+// GetBalance implements `Balances`.
+func (bb *bankBalances) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	balance := bb.getUnits(ctx, addr[:])
+	balance.Mul(balance, bb.factor)
+	return balance.Add(balance, bb.getRemainder(ctx, addr))
+}
+
+// SetBalance implements `Balances` by minting or burning the difference in whole units of the
+// denom and storing the new remainder of the balance.
+func (bb *bankBalances) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return ErrNegativeBalance
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	units, remainder := new(big.Int).QuoRem(amount, bb.factor, new(big.Int))
+	if err := bb.setUnits(ctx, addr[:], units); err != nil {
+		return err
+	}
+	return bb.setRemainder(ctx, addr, remainder)
+}
+
+// IterateBalances implements `Balances` by iterating over the bank balances of the denom, merged
+// in order of address with the accounts that only hold a remainder below one unit.
+func (bb *bankBalances) IterateBalances(
+	ctx sdk.Context, start common.Address, fn func(common.Address, *big.Int) bool,
+) error {
+	it := ctx.MultiStore().GetKVStore(bb.storeKey).Iterator(
+		BalanceKeyFor(start), storetypes.PrefixEndBytes([]byte{types.BalanceKeyPrefix}),
+	)
+
+	// remainders calls fn with the accounts that only hold a remainder, up to the given address
+	// if any.
+	var stopped bool
+	remainders := func(until *common.Address) {
+		for ; !stopped && it.Valid(); it.Next() {
+			addr := AddressFromBalanceKey(it.Key())
+			if until != nil && addr.Cmp(*until) >= 0 {
+				return
+			}
+			if bb.getUnits(ctx, addr[:]).Sign() != 0 {
+				continue
+			}
+			stopped = fn(addr, new(big.Int).SetBytes(it.Value()))
+		}
+	}
+
+	accounts := collections.NewPrefixedPairRange[string, sdk.AccAddress](bb.denom)
+	if err := bb.denoms.Walk(
+		ctx, accounts.StartInclusive(start[:]),
+		func(_ string, acc sdk.AccAddress) (bool, error) {
+			// Accounts that cannot be addressed by the EVM are skipped.
+			if len(acc) != common.AddressLength {
+				return false, nil
+			}
+			addr := common.BytesToAddress(acc)
+			if remainders(&addr); stopped {
+				return true, nil
+			}
+			balance := bb.getUnits(ctx, acc)
+			balance.Mul(balance, bb.factor)
+			stopped = fn(addr, balance.Add(balance, bb.getRemainder(ctx, addr)))
+			return stopped, nil
+		},
+	); err != nil {
+		return errors.Join(err, it.Close())
+	}
+	remainders(nil)
+	return it.Close()
+}
+
+// getUnits returns the whole units of the denom held by the given account in x/bank.
+func (bb *bankBalances) getUnits(ctx sdk.Context, acc sdk.AccAddress) *big.Int {
+	return bb.bk.GetBalance(ctx, acc, bb.denom).Amount.BigInt()
+}
+
+// setUnits mints or burns the difference between the given and the current whole units of the
+// denom held by the given account in x/bank.
+func (bb *bankBalances) setUnits(ctx sdk.Context, acc sdk.AccAddress, units *big.Int) error {
+	switch diff := new(big.Int).Sub(units, bb.getUnits(ctx, acc)); diff.Sign() {
+	case 1:
+		if err := bb.mint(ctx, diff); err != nil {
+			return err
+		}
+		if err := bb.bk.SendCoins(ctx, bb.reserve, acc, bb.coins(diff)); err != nil {
+			return errorslib.Wrapf(err, "failed to credit %s", bb.denom)
+		}
+	case -1:
+		diff.Neg(diff)
+		if err := bb.bk.SendCoinsFromAccountToModule(
+			ctx, acc, types.ModuleName, bb.coins(diff),
+		); err != nil {
+			return errorslib.Wrapf(err, "failed to debit %s", bb.denom)
+		}
+		return bb.burn(ctx, diff)
+	}
+	return nil
+}
+
+// getRemainder returns the remainder below one unit of the denom of the given account's balance.
+func (bb *bankBalances) getRemainder(ctx sdk.Context, addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(
+		ctx.MultiStore().GetKVStore(bb.storeKey).Get(BalanceKeyFor(addr)),
+	)
+}
+
+// setRemainder stores the remainder below one unit of the denom of the given account's balance
+// and keeps the reserve backing the sum of all remainders.
+func (bb *bankBalances) setRemainder(
+	ctx sdk.Context, addr common.Address, remainder *big.Int,
+) error {
+	old := bb.getRemainder(ctx, addr)
+	if remainder.Cmp(old) == 0 {
+		return nil
+	}
+
+	store := ctx.MultiStore().GetKVStore(bb.storeKey)
+	if remainder.Sign() == 0 {
+		store.Delete(BalanceKeyFor(addr))
+	} else {
+		store.Set(BalanceKeyFor(addr), remainder.Bytes())
+	}
+
+	supply := new(big.Int).SetBytes(store.Get([]byte{types.FractionalBalanceSupplyKey}))
+	newSupply := new(big.Int).Add(supply, remainder)
+	newSupply.Sub(newSupply, old)
+	store.Set([]byte{types.FractionalBalanceSupplyKey}, newSupply.Bytes())
+
+	// The reserve is topped up to the units needed to back the new supply. When the supply
+	// drops, only the units that backed the dropped remainders are burned, so that a reserve
+	// carried over from an exported genesis is never burned twice.
+	needed := bb.unitsToBack(newSupply)
+	held := bb.getUnits(ctx, bb.reserve)
+	if held.Cmp(needed) < 0 {
+		return bb.mint(ctx, new(big.Int).Sub(needed, held))
+	}
+	if newSupply.Cmp(supply) < 0 {
+		released := new(big.Int).Sub(bb.unitsToBack(supply), needed)
+		if excess := new(big.Int).Sub(held, needed); excess.Cmp(released) < 0 {
+			released = excess
+		}
+		if released.Sign() > 0 {
+			return bb.burn(ctx, released)
+		}
+	}
+	return nil
+}
+
+// unitsToBack returns the whole units of the denom needed to back the given amount of wei.
+func (bb *bankBalances) unitsToBack(amount *big.Int) *big.Int {
+	units, remainder := new(big.Int).QuoRem(amount, bb.factor, new(big.Int))
+	if remainder.Sign() > 0 {
+		units.Add(units, big.NewInt(1))
+	}
+	return units
+}
+
+// mint mints the given units of the denom to the evm module account.
+func (bb *bankBalances) mint(ctx sdk.Context, units *big.Int) error {
+	bb.markReserve(ctx)
+	if err := bb.bk.MintCoins(ctx, types.ModuleName, bb.coins(units)); err != nil {
+		return errorslib.Wrapf(err, "failed to mint %s", bb.denom)
+	}
+	return nil
+}
+
+// burn burns the given units of the denom from the evm module account.
+func (bb *bankBalances) burn(ctx sdk.Context, units *big.Int) error {
+	bb.markReserve(ctx)
+	if err := bb.bk.BurnCoins(ctx, types.ModuleName, bb.coins(units)); err != nil {
+		return errorslib.Wrapf(err, "failed to burn %s", bb.denom)
+	}
+	return nil
+}
+
+// markReserve marks the evm module account as dirty, as its balance changes with the reserve.
+func (bb *bankBalances) markReserve(ctx sdk.Context) {
+	ctx.MultiStore().GetKVStore(bb.storeKey).Set(
+		DirtyAccountKeyFor(common.BytesToAddress(bb.reserve)), []byte{1},
+	)
+}
+
+// coins returns the given units of the denom as coins.
+func (bb *bankBalances) coins(units *big.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(bb.denom, sdkmath.NewIntFromBigInt(units)))
+}
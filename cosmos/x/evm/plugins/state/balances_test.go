@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Balances", func() {
+	const denom = "abera"
+
+	var (
+		ctx sdk.Context
+		bk  bankkeeper.BaseKeeper
+		sp  state.Plugin
+	)
+
+	// setup resets the state plugin on a bank balance backend of the given decimals.
+	setup := func(decimals uint8) {
+		var ak state.AccountKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		balances, err := state.NewBankBalances(
+			bk, bk.Balances.Indexes.Denom, testutil.EvmKey,
+			state.BankBalancesConfig{Denom: denom, Decimals: decimals},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		sp = state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+		sp.SetBalances(balances)
+		sp.Reset(ctx)
+	}
+
+	bankBalance := func(addr common.Address) *big.Int {
+		return bk.GetBalance(sp.GetContext(), addr[:], denom).Amount.BigInt()
+	}
+
+	supply := func() *big.Int {
+		return bk.GetSupply(sp.GetContext(), denom).Amount.BigInt()
+	}
+
+	It("should reject denoms with more decimals than the evm", func() {
+		_, err := state.NewBankBalances(
+			nil, nil, testutil.EvmKey, state.BankBalancesConfig{Denom: denom, Decimals: 19},
+		)
+		Expect(err).To(MatchError(state.ErrInvalidDecimals))
+	})
+
+	When("the denom has 18 decimals", func() {
+		BeforeEach(func() {
+			setup(18)
+		})
+
+		It("should keep the balances in x/bank", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			Expect(bankBalance(alice)).To(Equal(big.NewInt(100)))
+			Expect(supply()).To(Equal(big.NewInt(100)))
+
+			sp.SubBalance(alice, big.NewInt(40))
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(60)))
+			Expect(bankBalance(alice)).To(Equal(big.NewInt(60)))
+			Expect(supply()).To(Equal(big.NewInt(60)))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+		})
+
+		It("should see the coins minted by x/bank", func() {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(7)))
+			Expect(bk.MintCoins(sp.GetContext(), evmtypes.ModuleName, coins)).To(Succeed())
+			Expect(bk.SendCoinsFromModuleToAccount(
+				sp.GetContext(), evmtypes.ModuleName, bob[:], coins,
+			)).To(Succeed())
+			Expect(sp.GetBalance(bob)).To(Equal(big.NewInt(7)))
+		})
+
+		It("should revert the bank balances with the snapshots", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			revision := sp.Snapshot()
+			sp.SubBalance(alice, big.NewInt(100))
+			sp.AddBalance(bob, big.NewInt(100))
+			Expect(bankBalance(bob)).To(Equal(big.NewInt(100)))
+
+			sp.RevertToSnapshot(revision)
+			Expect(bankBalance(alice)).To(Equal(big.NewInt(100)))
+			Expect(bankBalance(bob)).To(Equal(new(big.Int)))
+			Expect(supply()).To(Equal(big.NewInt(100)))
+		})
+
+		It("should only iterate over the balances of the denom", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			other := sdk.NewCoins(sdk.NewInt64Coin("other", 5))
+			Expect(bk.MintCoins(sp.GetContext(), evmtypes.ModuleName, other)).To(Succeed())
+			Expect(bk.SendCoinsFromModuleToAccount(
+				sp.GetContext(), evmtypes.ModuleName, bob[:], other,
+			)).To(Succeed())
+
+			balances := make(map[common.Address]*big.Int)
+			sp.IterateBalances(common.Address{}, func(addr common.Address, balance *big.Int) bool {
+				balances[addr] = balance
+				return false
+			})
+			Expect(balances).To(Equal(map[common.Address]*big.Int{alice: big.NewInt(100)}))
+		})
+	})
+
+	When("the denom has 6 decimals", func() {
+		// unit is the number of wei in one unit of the denom.
+		unit := big.NewInt(1e12)
+
+		BeforeEach(func() {
+			setup(6)
+		})
+
+		It("should scale the balances and back the remainders", func() {
+			sp.AddBalance(alice, big.NewInt(2e12+5))
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(2e12 + 5)))
+			Expect(bankBalance(alice)).To(Equal(big.NewInt(2)))
+			// One unit of reserve backs the remainder.
+			Expect(supply()).To(Equal(big.NewInt(3)))
+
+			sp.AddBalance(bob, big.NewInt(1e12-5))
+			Expect(bankBalance(bob)).To(Equal(new(big.Int)))
+			Expect(supply()).To(Equal(big.NewInt(3)))
+
+			sp.AddBalance(bob, big.NewInt(1))
+			Expect(supply()).To(Equal(big.NewInt(4)))
+
+			sp.SubBalance(alice, big.NewInt(5))
+			sp.SubBalance(bob, big.NewInt(1e12-4))
+			Expect(supply()).To(Equal(big.NewInt(2)))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+		})
+
+		It("should keep the supply backing every wei", func() {
+			amounts := []int64{1, 3e11, 7e12 + 9, 5e11, 2}
+			for i, amount := range amounts {
+				addr := common.BigToAddress(big.NewInt(int64(i + 1)))
+				sp.AddBalance(addr, big.NewInt(amount))
+			}
+			sp.SubBalance(common.BigToAddress(big.NewInt(3)), big.NewInt(4e12))
+
+			// The reserve itself is not backed by anything but its own units.
+			reserve := common.BytesToAddress(authtypes.NewModuleAddress(evmtypes.ModuleName))
+			total := new(big.Int)
+			sp.IterateBalances(common.Address{}, func(addr common.Address, balance *big.Int) bool {
+				if addr != reserve {
+					total.Add(total, balance)
+				}
+				return false
+			})
+			backed := new(big.Int).Mul(supply(), unit)
+			Expect(backed.Cmp(total)).To(BeNumerically(">=", 0))
+			Expect(new(big.Int).Sub(backed, total).Cmp(unit)).To(Equal(-1))
+		})
+
+		It("should iterate over the accounts that only hold a remainder", func() {
+			sp.AddBalance(alice, big.NewInt(1e12))
+			sp.AddBalance(bob, big.NewInt(1))
+
+			balances := make(map[common.Address]*big.Int)
+			sp.IterateBalances(common.Address{}, func(addr common.Address, balance *big.Int) bool {
+				balances[addr] = balance
+				return false
+			})
+			Expect(balances).To(HaveKeyWithValue(alice, big.NewInt(1e12)))
+			Expect(balances).To(HaveKeyWithValue(bob, big.NewInt(1)))
+		})
+
+		It("should iterate over the balances in order of address from the start", func() {
+			addrs := make([]common.Address, 4)
+			for i := range addrs {
+				addrs[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+				// Every other account only holds a remainder.
+				amount := big.NewInt(int64(i + 1))
+				if i%2 == 0 {
+					amount.Mul(amount, unit)
+				}
+				sp.AddBalance(addrs[i], amount)
+			}
+
+			var iterated []common.Address
+			sp.IterateBalances(addrs[1], func(addr common.Address, _ *big.Int) bool {
+				iterated = append(iterated, addr)
+				return len(iterated) == 2
+			})
+			Expect(iterated).To(Equal(addrs[1:3]))
+		})
+	})
+})
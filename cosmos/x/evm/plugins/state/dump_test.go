@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	ethstate "github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dump", func() {
+	var (
+		sdb   ethstate.StateDB
+		slot  = common.Hash{1}
+		value = common.BigToHash(big.NewInt(2))
+		code  = []byte{1, 2, 3}
+	)
+
+	BeforeEach(func() {
+		ctx, ak, _, _ := testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		sp := state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+		sp.Reset(ctx)
+		sdb = ethstate.NewStateDB(sp, nil)
+
+		sdb.CreateAccount(alice)
+		sdb.AddBalance(alice, big.NewInt(100))
+		sdb.SetNonce(alice, 2)
+		sdb.CreateAccount(bob)
+		sdb.SetCode(bob, code)
+		sdb.SetState(bob, slot, value)
+		sdb.Finalise(true)
+	})
+
+	It("should dump all the accounts", func() {
+		dump := sdb.RawDump(nil)
+		Expect(dump.Next).To(BeNil())
+		Expect(dump.Accounts).To(HaveLen(2))
+
+		aliceDump := dump.Accounts[alice.String()]
+		Expect(aliceDump.Balance).To(Equal("100"))
+		Expect(aliceDump.Nonce).To(Equal(uint64(2)))
+		Expect(*aliceDump.Address).To(Equal(alice))
+
+		bobDump := dump.Accounts[bob.String()]
+		Expect([]byte(bobDump.Code)).To(Equal(code))
+		Expect(bobDump.Storage).To(Equal(map[common.Hash]string{slot: "02"}))
+	})
+
+	It("should dump the accounts with only a nonce or storage", func() {
+		nonceOnly, storageOnly := common.Address{0xa}, common.Address{0xb}
+		sdb.SetNonce(nonceOnly, 3)
+		sdb.SetState(storageOnly, slot, value)
+		sdb.Finalise(true)
+
+		dump := sdb.RawDump(nil)
+		Expect(dump.Accounts).To(HaveLen(4))
+		Expect(dump.Accounts[nonceOnly.String()].Nonce).To(Equal(uint64(3)))
+		Expect(dump.Accounts[storageOnly.String()].Storage).To(
+			Equal(map[common.Hash]string{slot: "02"}),
+		)
+	})
+
+	It("should skip the code and storage", func() {
+		dump := sdb.RawDump(&gethstate.DumpConfig{SkipCode: true, SkipStorage: true})
+		bobDump := dump.Accounts[bob.String()]
+		Expect(bobDump.Code).To(BeEmpty())
+		Expect(bobDump.Storage).To(BeNil())
+	})
+
+	It("should page the accounts by address", func() {
+		first, second := alice, bob
+		if bob.Cmp(alice) < 0 {
+			first, second = bob, alice
+		}
+
+		dump := sdb.RawDump(&gethstate.DumpConfig{Max: 1})
+		Expect(dump.Accounts).To(HaveLen(1))
+		Expect(dump.Accounts).To(HaveKey(first.String()))
+		Expect(dump.Next).To(Equal(second.Bytes()))
+
+		dump = sdb.RawDump(&gethstate.DumpConfig{Start: dump.Next, Max: 1})
+		Expect(dump.Accounts).To(HaveLen(1))
+		Expect(dump.Accounts).To(HaveKey(second.String()))
+		Expect(dump.Next).To(BeNil())
+	})
+})
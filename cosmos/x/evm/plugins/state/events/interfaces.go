@@ -23,6 +23,7 @@ package events
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -33,6 +34,14 @@ type (
 		Build(*sdk.Event) (*ethtypes.Log, error)
 	}
 
+	// InstanceLogFactory is an optional interface for precompile log factories, which log the
+	// Cosmos events emitted by a dynamic precompile at the address of the instance.
+	InstanceLogFactory interface {
+		// BuildFor builds an Ethereum log from a Cosmos event, which is emitted during the
+		// execution of the precompile at the given address.
+		BuildFor(common.Address, *sdk.Event) (*ethtypes.Log, error)
+	}
+
 	// LogsDB defines the required function to add a log to the StateDB. This ensures a
 	// precompile runner can only add logs to the StateDB and not modify any other state on the
 	// StateDB.
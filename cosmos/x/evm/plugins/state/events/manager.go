@@ -28,6 +28,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 )
 
@@ -50,6 +52,8 @@ type manager struct {
 	ldb LogsDB
 	// plf is used to build Eth logs from Cosmos events.
 	plf PrecompileLogFactory
+	// precompile is the address of the precompile that is currently executing.
+	precompile common.Address
 	// readOnly is true if the EVM is in read-only mode
 	readOnly bool
 }
@@ -88,6 +92,14 @@ func (m *manager) EndPrecompileExecution() {
 	m.ldb = nil
 }
 
+// SetPrecompile sets the address of the precompile that is currently executing, for which the
+// Eth logs are built, and returns the address of the previously executing precompile.
+func (m *manager) SetPrecompile(precompile common.Address) common.Address {
+	prev := m.precompile
+	m.precompile = precompile
+	return prev
+}
+
 // EmitEvent overrides the Cosmos SDK's `EventManager.EmitEvent` method to build Eth logs from
 // the emitted event and add them to the journal.
 func (m *manager) EmitEvent(event sdk.Event) {
@@ -146,7 +158,15 @@ func (m *manager) Finalize() {}
 
 // convertToLog builds an Eth log from the given Cosmos event and adds it to the logs journal.
 func (m *manager) convertToLog(event *sdk.Event) {
-	log, err := m.plf.Build(event)
+	var (
+		log *ethtypes.Log
+		err error
+	)
+	if ilf, ok := m.plf.(InstanceLogFactory); ok {
+		log, err = ilf.BuildFor(m.precompile, event)
+	} else {
+		log, err = m.plf.Build(event)
+	}
 	if err != nil {
 		if errors.Is(err, ErrEthEventNotRegistered) {
 			return
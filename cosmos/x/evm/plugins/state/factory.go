@@ -49,6 +49,10 @@ type SPFactory struct {
 
 	// Query function for getting the context at a given height.
 	qfn func() func(height int64, prove bool) (sdk.Context, error) // "historical"
+	// Query function for proving the evm store.
+	sqf func() StoreQueryFn
+	// Backend of the native balances of the EVM accounts.
+	balances Balances
 }
 
 // NewSPFactory creates a new SPFactory instance with the provided AccountKeeper,
@@ -62,6 +66,7 @@ func NewSPFactory(
 		ak:       ak,
 		storeKey: storeKey,
 		qfn:      qfn,
+		balances: NewStoreBalances(storeKey),
 	}
 }
 
@@ -69,6 +74,8 @@ func NewSPFactory(
 // configuration and the provided context.
 func (spf *SPFactory) NewPluginWithMode(mode state.Mode) core.StatePlugin {
 	p := NewPlugin(spf.ak, spf.storeKey, spf.qfn, spf.plf)
+	p.SetStoreQueryFn(spf.sqf)
+	p.SetBalances(spf.balances)
 	switch mode {
 	case state.Genesis:
 		p.Reset(spf.genesisContext)
@@ -78,6 +85,8 @@ func (spf *SPFactory) NewPluginWithMode(mode state.Mode) core.StatePlugin {
 		p.Reset(spf.insertChainContext)
 	case state.Finalize:
 		p.Reset(spf.finalizeBlockContext)
+	case state.Simulation:
+		p.Reset(spf.simulationContext())
 	case state.Latest:
 		fallthrough
 	default:
@@ -92,6 +101,8 @@ func (spf *SPFactory) NewPluginWithMode(mode state.Mode) core.StatePlugin {
 // one provided.
 func (spf *SPFactory) NewPluginFromContext(ctx context.Context) core.StatePlugin {
 	p := NewPlugin(spf.ak, spf.storeKey, spf.qfn, spf.plf)
+	p.SetStoreQueryFn(spf.sqf)
+	p.SetBalances(spf.balances)
 	p.Reset(ctx)
 	return p
 }
@@ -116,6 +127,20 @@ func (spf *SPFactory) NewPluginAtBlockNumber(blockNumber int64) (core.StatePlugi
 	return spf.NewPluginFromContext(ctx), nil
 }
 
+// simulationContext returns a context branched from the latest committed state of the host chain.
+// Unlike branches of the `latestQueryContext`, which all read through the same cache, every
+// simulation context reads the committed store on its own. It falls back to a branch of the
+// `latestQueryContext` before the first block is committed.
+func (spf *SPFactory) simulationContext() sdk.Context {
+	if spf.qfn != nil {
+		if ctx, err := spf.qfn()(0, false); err == nil {
+			return ctx
+		}
+	}
+	ctx, _ := spf.latestQueryContext.CacheContext()
+	return ctx
+}
+
 // SetGenesisContext updates the SPFactory's genesis context to the provided context.
 func (spf *SPFactory) SetGenesisContext(ctx context.Context) {
 	spf.genesisContext = sdk.UnwrapSDKContext(ctx)
@@ -136,6 +161,16 @@ func (spf *SPFactory) SetFinalizeBlockContext(ctx context.Context) {
 	spf.finalizeBlockContext = sdk.UnwrapSDKContext(ctx)
 }
 
+// SetStoreQueryFn sets the function used by the state plugins to query proofs of the evm store.
+func (spf *SPFactory) SetStoreQueryFn(sqf func() StoreQueryFn) {
+	spf.sqf = sqf
+}
+
+// SetBalances sets the backend of the native balances used by the state plugins.
+func (spf *SPFactory) SetBalances(balances Balances) {
+	spf.balances = balances
+}
+
 // SetLatestQueryContext updates the SPFactory's latestQueryContext to the provided context.
 // This context will be used for subsequent state queries.
 //
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	ethstate "github.com/berachain/polaris/eth/core/state"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SPFactory", func() {
+	var (
+		ctx sdk.Context
+		spf *state.SPFactory
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		spf = state.NewSPFactory(ak, testutil.EvmKey, nil)
+		spf.SetPrecompileLogFactory(&mockPLF{})
+
+		sp := state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+		sp.Reset(ctx)
+		sp.AddBalance(alice, big.NewInt(100))
+		sp.Finalize()
+		spf.SetLatestQueryContext(ctx)
+	})
+
+	It("should isolate the simulation states", func() {
+		first := spf.NewPluginWithMode(ethstate.Simulation)
+		second := spf.NewPluginWithMode(ethstate.Simulation)
+		Expect(first.GetBalance(alice)).To(Equal(big.NewInt(100)))
+
+		first.AddBalance(alice, big.NewInt(1))
+		first.Finalize()
+		Expect(first.GetBalance(alice)).To(Equal(big.NewInt(101)))
+		Expect(second.GetBalance(alice)).To(Equal(big.NewInt(100)))
+		Expect(spf.NewPluginFromContext(ctx).GetBalance(alice)).To(Equal(big.NewInt(100)))
+	})
+})
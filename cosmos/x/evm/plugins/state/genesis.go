@@ -80,6 +80,9 @@ func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
 		}
 	}
 
+	// Index the accounts that were initialized by the genesis of the other modules.
+	p.indexAccounts()
+
 	p.Finalize()
 	return nil
 }
@@ -90,7 +93,7 @@ func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	ethGen.Alloc = make(core.GenesisAlloc)
 
 	// Iterate Balances and set the genesis accounts.
-	p.IterateBalances(func(address common.Address, balance *big.Int) bool {
+	p.IterateBalances(common.Address{}, func(address common.Address, balance *big.Int) bool {
 		account, ok := ethGen.Alloc[address]
 		if !ok {
 			account = core.GenesisAccount{}
@@ -106,7 +109,7 @@ func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	})
 
 	// Iterate Storage and set the genesis accounts.
-	p.IterateState(func(address common.Address, key common.Hash, value common.Hash) bool {
+	p.IterateState(common.Address{}, func(address common.Address, key, value common.Hash) bool {
 		account, ok := ethGen.Alloc[address]
 		if !ok {
 			account = core.GenesisAccount{}
@@ -125,7 +128,7 @@ func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	})
 
 	// Iterate Code and set the genesis accounts.
-	p.IterateCode(func(address common.Address, codeHash common.Hash) bool {
+	p.IterateCode(common.Address{}, func(address common.Address, codeHash common.Hash) bool {
 		account, ok := ethGen.Alloc[address]
 		if !ok {
 			account = core.GenesisAccount{}
@@ -23,6 +23,7 @@ package state
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 
@@ -30,6 +31,8 @@ import (
 	libtypes "github.com/berachain/polaris/lib/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // ControllableEventManager defines a cache EventManager that is controllable (snapshottable
@@ -43,6 +46,8 @@ type ControllableEventManager interface {
 	BeginPrecompileExecution(events.LogsDB)
 	// EndPrecompileExecution ends a precompile execution by resetting the logs DB to nil.
 	EndPrecompileExecution()
+	// SetPrecompile sets the address of the executing precompile and returns the previous one.
+	SetPrecompile(common.Address) common.Address
 
 	// IsReadOnly returns true if the EventManager is read-only.
 	IsReadOnly() bool
@@ -72,3 +77,25 @@ type AccountKeeper interface {
 	RemoveAccount(ctx context.Context, account sdk.AccountI)
 	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) bool)
 }
+
+// BankKeeper defines the expected bank keeper, used by the bank balance backend.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(
+		ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+	) error
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DenomIndex defines the expected index of the bank balances by denom, i.e.
+// `Balances.Indexes.Denom` of the bank keeper. It is used by the bank balance backend to only
+// iterate over the balances of its denom.
+type DenomIndex interface {
+	Walk(
+		ctx context.Context,
+		ranger collections.Ranger[collections.Pair[string, sdk.AccAddress]],
+		walkFunc func(denom string, addr sdk.AccAddress) (stop bool, err error),
+	) error
+}
@@ -92,3 +92,66 @@ func AddressFromCodeHashKey(key []byte) common.Address {
 func AddressFromBalanceKey(key []byte) common.Address {
 	return common.BytesToAddress(key[1:])
 }
+
+// StorageRootKeyFor defines the full key under which the storage root of an account is stored.
+func StorageRootKeyFor(address common.Address) []byte {
+	bz := make([]byte, 1+common.AddressLength)
+	copy(bz, []byte{types.StorageRootKeyPrefix})
+	copy(bz[1:], address[:])
+	return bz
+}
+
+// DirtyStoragePrefixFor returns a prefix to iterate over the dirty storage slots of an account.
+func DirtyStoragePrefixFor(address common.Address) []byte {
+	bz := make([]byte, 1+common.AddressLength)
+	copy(bz, []byte{types.DirtyStorageKeyPrefix})
+	copy(bz[1:], address[:])
+	return bz
+}
+
+// DirtyStorageKeyFor defines the full key under which a storage slot of an account is marked as
+// changed since the storage trie of the account was last updated.
+func DirtyStorageKeyFor(address common.Address, slot common.Hash) []byte {
+	bz := make([]byte, 1+common.AddressLength+common.HashLength)
+	copy(bz, []byte{types.DirtyStorageKeyPrefix})
+	copy(bz[1:], address[:])
+	copy(bz[1+common.AddressLength:], slot[:])
+	return bz
+}
+
+// DirtyAccountKeyFor defines the full key under which an account is marked as changed since its
+// leaf in the account trie was last updated.
+func DirtyAccountKeyFor(address common.Address) []byte {
+	bz := make([]byte, 1+common.AddressLength)
+	copy(bz, []byte{types.DirtyAccountKeyPrefix})
+	copy(bz[1:], address[:])
+	return bz
+}
+
+// TrieNodeKeyFor defines the full key under which a trie node is stored by its hash.
+func TrieNodeKeyFor(hash common.Hash) []byte {
+	bz := make([]byte, 1+common.HashLength)
+	copy(bz, []byte{types.TrieNodeKeyPrefix})
+	copy(bz[1:], hash[:])
+	return bz
+}
+
+// TrieNodeRefKeyFor defines the full key under which the number of references to a trie node is
+// stored.
+func TrieNodeRefKeyFor(hash common.Hash) []byte {
+	bz := make([]byte, 1+common.HashLength)
+	copy(bz, []byte{types.TrieNodeRefKeyPrefix})
+	copy(bz[1:], hash[:])
+	return bz
+}
+
+// TriePathKeyFor defines the full key under which the hash of the trie node at the given path of
+// the given trie is stored. The owner is the zero hash for the account trie, and the hash of the
+// account address for a storage trie.
+func TriePathKeyFor(owner common.Hash, path []byte) []byte {
+	bz := make([]byte, 1+common.HashLength+len(path))
+	copy(bz, []byte{types.TriePathKeyPrefix})
+	copy(bz[1:], owner[:])
+	copy(bz[1+common.HashLength:], path)
+	return bz
+}
@@ -21,6 +21,7 @@
 package state
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"math/big"
@@ -56,14 +57,18 @@ var (
 type Plugin interface {
 	plugins.HasGenesis
 	core.StatePlugin
-	// IterateBalances iterates over the balances of all accounts and calls the callback function.
-	IterateBalances(fn func(common.Address, *big.Int) bool)
-	// IterateState iterates over the state of all accounts and calls the callback function.
-	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
+	ethstate.ProofPlugin
+	ethstate.IterablePlugin
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 	// SetPrecompileLogFactory sets the precompile log factory for the plugin.
 	SetPrecompileLogFactory(events.PrecompileLogFactory)
+	// SetStoreQueryFn sets the function used to query proofs of the evm store.
+	SetStoreQueryFn(func() StoreQueryFn)
+	// SetBalances sets the backend that holds the native balances of the EVM accounts.
+	SetBalances(Balances)
+	// IndexAccounts marks the whole state as dirty, so that the state root is rebuilt from it.
+	IndexAccounts(sdk.Context)
 }
 
 // The StatePlugin is a very fun and interesting part of the EVM implementation. But if you want to
@@ -106,17 +111,24 @@ type plugin struct {
 	// keepers used for balance and account information.
 	ak AccountKeeper
 
+	// balances is the backend that holds the native balances of the EVM accounts.
+	balances Balances
+
 	// dbErr stores any error that is returned from state modifications on the underlying
 	// keepers.
 	dbErr error
 
-	mu sync.Mutex
+	// root caches the state root until the state is modified.
+	root *common.Hash
 
-	stateCtx context.Context
+	mu sync.Mutex
 
 	// lqc is used for fulfilling
 	lqc sdk.Context
 	qfn func() func(height int64, prove bool) (sdk.Context, error)
+
+	// sqf is used for proving the evm store.
+	sqf func() StoreQueryFn
 }
 
 // NewPlugin returns a plugin with the given context and keepers.
@@ -129,12 +141,18 @@ func NewPlugin(
 	return &plugin{
 		storeKey: storeKey,
 		ak:       ak,
+		balances: NewStoreBalances(storeKey),
 		plf:      plf,
 		mu:       sync.Mutex{},
 		qfn:      qfn,
 	}
 }
 
+// SetBalances sets the backend that holds the native balances of the EVM accounts.
+func (p *plugin) SetBalances(balances Balances) {
+	p.balances = balances
+}
+
 // SetupForPrecompiles sets the precompile plugin and the log factory on the state plugin.
 func (p *plugin) SetPrecompileLogFactory(plf events.PrecompileLogFactory) {
 	p.plf = plf
@@ -174,6 +192,14 @@ func (p *plugin) Reset(ctx context.Context) {
 
 	// We reset the saved error, so that we can check for errors in the next state transition.
 	p.dbErr = nil
+	p.root = nil
+}
+
+// RevertToSnapshot reverts the state to the given snapshot, which invalidates the cached state
+// root.
+func (p *plugin) RevertToSnapshot(id int) {
+	p.root = nil
+	p.Controller.RevertToSnapshot(id)
 }
 
 // RegistryKey implements `libtypes.Registrable`.
@@ -181,8 +207,10 @@ func (p *plugin) RegistryKey() string {
 	return pluginRegistryKey
 }
 
-// GetContext implements `core.StatePlugin`.
+// GetContext implements `core.StatePlugin`. The state may be modified through the returned
+// context, such as by precompiles, so the cached state root is invalidated.
 func (p *plugin) GetContext() context.Context {
+	p.root = nil
 	return p.ctx
 }
 
@@ -204,6 +232,7 @@ func (p *plugin) Finalize() {
 func (p *plugin) CreateAccount(addr common.Address) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	p.markDirty(addr)
 	if p.ak.GetAccount(p.ctx, addr[:]) == nil {
 		p.ak.SetAccount(p.ctx, p.ak.NewAccountWithAddress(p.ctx, addr[:]))
 	}
@@ -229,6 +258,7 @@ func (p *plugin) GetNonce(addr common.Address) uint64 {
 func (p *plugin) SetNonce(addr common.Address, nonce uint64) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	p.markDirty(addr)
 
 	// get the account or create a new one if doesn't exist
 	acc := p.ak.GetAccount(p.ctx, addr[:])
@@ -243,6 +273,20 @@ func (p *plugin) SetNonce(addr common.Address, nonce uint64) {
 	p.ak.SetAccount(p.ctx, acc)
 }
 
+// IterateNonces iterates over the nonces of all the accounts with a nonce, in ascending order of
+// address starting at the given address, and calls the given function. The accounts are ordered
+// by address in the account keeper, so the iteration stops as soon as the function returns true.
+func (p *plugin) IterateNonces(start common.Address, fn func(common.Address, uint64) bool) {
+	p.ak.IterateAccounts(p.ctx, func(acc sdk.AccountI) bool {
+		addr := acc.GetAddress()
+		if len(addr) != common.AddressLength || acc.GetSequence() == 0 ||
+			bytes.Compare(addr, start[:]) < 0 {
+			return false
+		}
+		return fn(common.BytesToAddress(addr), acc.GetSequence())
+	})
+}
+
 // Exist implements the `StatePlugin` interface by reporting whether the given account address
 // exists in the state. Notably this also returns true for suicided accounts, which is accounted
 // for since, `RemoveAccount()` is not called until Commit.
@@ -269,13 +313,10 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 			// handles the double suicide case
 			continue
 		}
+		p.markDirty(account)
 
 		// clear storage
-		_ = p.ForEachStorage(account,
-			func(key, _ common.Hash) bool {
-				p.SetState(account, key, common.Hash{})
-				return true
-			})
+		p.clearStorage(account)
 
 		// clear the codehash from this account
 		p.cms.GetKVStore(p.storeKey).Delete(CodeHashKeyFor(account))
@@ -291,12 +332,15 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 
 // GetBalance implements `StatePlugin` interface.
 func (p *plugin) GetBalance(addr common.Address) *big.Int {
-	return new(big.Int).SetBytes(p.cms.GetKVStore(p.storeKey).Get(BalanceKeyFor(addr)))
+	return p.balances.GetBalance(p.ctx, addr)
 }
 
 // SetBalance implements `StatePlugin` interface.
 func (p *plugin) SetBalance(addr common.Address, amount *big.Int) {
-	p.cms.GetKVStore(p.storeKey).Set(BalanceKeyFor(addr), amount.Bytes())
+	p.markDirty(addr)
+	if err := p.balances.SetBalance(p.ctx, addr, amount); err != nil {
+		p.dbErr = err
+	}
 }
 
 // AddBalance implements the `StatePlugin` interface by adding the given amount
@@ -353,6 +397,8 @@ func (p *plugin) GetCode(addr common.Address) []byte {
 // SetCode implements the `StatePlugin` interface by setting the code hash and
 // code for the given account.
 func (p *plugin) SetCode(addr common.Address, code []byte) {
+	p.markDirty(addr)
+
 	codeHash := crypto.Keccak256Hash(code)
 	ethStore := p.cms.GetKVStore(p.storeKey)
 	ethStore.Set(CodeHashKeyFor(addr), codeHash[:])
@@ -365,25 +411,15 @@ func (p *plugin) SetCode(addr common.Address, code []byte) {
 	}
 }
 
-// IterateCode iterates over all the contract code, and calls the given function.
-func (p *plugin) IterateCode(fn func(addr common.Address, value common.Hash) bool) {
-	it := storetypes.KVStorePrefixIterator(
-		p.cms.GetKVStore(p.storeKey),
-		[]byte{types.CodeHashKeyPrefix},
+// IterateCode iterates over the code hashes of all the accounts, in ascending order of address
+// starting at the given address, and calls the given function.
+func (p *plugin) IterateCode(start common.Address, fn func(common.Address, common.Hash) bool) {
+	p.iterateFrom(
+		p.cms.GetKVStore(p.storeKey), CodeHashKeyFor(start), types.CodeHashKeyPrefix,
+		func(key, value []byte) bool {
+			return fn(AddressFromCodeHashKey(key), common.BytesToHash(value))
+		},
 	)
-	defer func() {
-		if err := it.Close(); err != nil {
-			p.dbErr = err
-		}
-	}()
-
-	for ; it.Valid(); it.Next() {
-		k := it.Key()
-		addr := AddressFromCodeHashKey(k)
-		if fn(addr, p.GetCodeHash(addr)) {
-			break
-		}
-	}
 }
 
 // =============================================================================
@@ -414,30 +450,65 @@ func (p *plugin) SetState(addr common.Address, key, value common.Hash) {
 	// hash.
 	//
 	// CONTRACT: never manually call SetState outside of `opSstore`, or InitGenesis.
+	store := p.cms.GetKVStore(p.storeKey)
+
+	// Mark the slot as dirty, so that it is updated in the storage trie of the account.
+	p.markDirty(addr)
+	store.Set(DirtyStorageKeyFor(addr, key), []byte{1})
 
 	// If empty value is given, delete the state entry.
 	if len(value) == 0 || (value == common.Hash{}) {
-		p.cms.GetKVStore(p.storeKey).Delete(SlotKeyFor(addr, key))
+		store.Delete(SlotKeyFor(addr, key))
 		return
 	}
 
 	// Set the state entry.
-	p.cms.GetKVStore(p.storeKey).Set(SlotKeyFor(addr, key), value[:])
+	store.Set(SlotKeyFor(addr, key), value[:])
 }
 
-// SetStorage sets the storage of an address.
+// SetStorage replaces the whole storage of an address with the given storage, as required by
+// the full state overrides of `eth_call`.
 func (p *plugin) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	p.clearStorage(addr)
 	for key, value := range storage {
 		p.SetState(addr, key, value)
 	}
 }
 
-// IterateState iterates over all the contract state, and calls the given function.
-func (p *plugin) IterateState(cb func(addr common.Address, key, value common.Hash) bool) {
-	it := storetypes.KVStorePrefixIterator(
-		p.cms.GetCommittedKVStore(p.storeKey),
-		[]byte{types.StorageKeyPrefix},
+// clearStorage deletes every storage slot of an address. The slots are collected first, so that
+// the store is not written to while iterating.
+func (p *plugin) clearStorage(addr common.Address) {
+	var slots []common.Hash
+	if err := p.ForEachStorage(addr, func(slot, _ common.Hash) bool {
+		slots = append(slots, slot)
+		return true
+	}); err != nil {
+		p.dbErr = err
+	}
+	for _, slot := range slots {
+		p.SetState(addr, slot, common.Hash{})
+	}
+}
+
+// IterateState iterates over the storage slots of all the accounts, in ascending order of address
+// starting at the given address, and calls the given function.
+func (p *plugin) IterateState(
+	start common.Address, cb func(addr common.Address, key, value common.Hash) bool,
+) {
+	p.iterateFrom(
+		p.cms.GetCommittedKVStore(p.storeKey), StorageKeyFor(start), types.StorageKeyPrefix,
+		func(key, value []byte) bool {
+			return cb(AddressFromSlotKey(key), SlotFromSlotKey(key), common.BytesToHash(value))
+		},
 	)
+}
+
+// iterateFrom calls fn with every key and value under the given prefix of the given store,
+// starting at the given key, until fn returns true.
+func (p *plugin) iterateFrom(
+	store storetypes.KVStore, start []byte, prefix byte, fn func(key, value []byte) bool,
+) {
+	it := store.Iterator(start, storetypes.PrefixEndBytes([]byte{prefix}))
 	defer func() {
 		if err := it.Close(); err != nil {
 			p.dbErr = err
@@ -445,10 +516,7 @@ func (p *plugin) IterateState(cb func(addr common.Address, key, value common.Has
 	}()
 
 	for ; it.Valid(); it.Next() {
-		k, v := it.Key(), it.Value()
-		addr := AddressFromSlotKey(k)
-		slot := SlotFromSlotKey(k)
-		if cb(addr, slot, common.BytesToHash(v)) {
+		if fn(it.Key(), it.Value()) {
 			break
 		}
 	}
@@ -462,19 +530,19 @@ func (p *plugin) IterateState(cb func(addr common.Address, key, value common.Has
 func (p *plugin) ForEachStorage(
 	addr common.Address,
 	cb func(key, value common.Hash) bool,
-) error {
+) (err error) {
 	it := storetypes.KVStorePrefixIterator(
 		p.cms.GetKVStore(p.storeKey),
 		StorageKeyFor(addr),
 	)
-	if err := it.Close(); err != nil {
-		return err
-	}
+	defer func() {
+		err = errors.Join(err, it.Close())
+	}()
 
 	for ; it.Valid(); it.Next() {
 		committedValue := it.Value()
 		if len(committedValue) > 0 {
-			if !cb(common.BytesToHash(it.Key()), common.BytesToHash(committedValue)) {
+			if !cb(SlotFromSlotKey(it.Key()), common.BytesToHash(committedValue)) {
 				return nil // stop iteration
 			}
 		}
@@ -494,22 +562,11 @@ func getStateFromStore(
 	return common.Hash{}
 }
 
-func (p *plugin) IterateBalances(fn func(common.Address, *big.Int) bool) {
-	it := storetypes.KVStorePrefixIterator(
-		p.cms.GetKVStore(p.storeKey),
-		[]byte{types.BalanceKeyPrefix},
-	)
-	defer func() {
-		if err := it.Close(); err != nil {
-			p.dbErr = err
-		}
-	}()
-
-	for ; it.Valid(); it.Next() {
-		addr := AddressFromBalanceKey(it.Key())
-		if fn(addr, p.GetBalance(addr)) {
-			break
-		}
+// IterateBalances iterates over the balances of all the accounts, in ascending order of address
+// starting at the given address, and calls the given function.
+func (p *plugin) IterateBalances(start common.Address, fn func(common.Address, *big.Int) bool) {
+	if err := p.balances.IterateBalances(p.ctx, start, fn); err != nil {
+		p.dbErr = err
 	}
 }
 
@@ -517,16 +574,6 @@ func (p *plugin) IterateBalances(fn func(common.Address, *big.Int) bool) {
 // Historical State
 // =============================================================================
 
-func (p *plugin) SetStateOverride(ctx context.Context) {
-	p.stateCtx = ctx
-}
-
-func (p *plugin) GetOverridenState() core.StatePlugin {
-	sp := NewPlugin(p.ak, p.storeKey, p.qfn, p.plf)
-	sp.Reset(p.stateCtx)
-	return sp
-}
-
 // StateAtBlockNumber implements `core.StatePlugin`.
 func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 	var ctx sdk.Context
@@ -562,6 +609,8 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 
 	// Create a State Plugin with the requested chain height.
 	sp := NewPlugin(p.ak, p.storeKey, p.qfn, p.plf)
+	sp.SetStoreQueryFn(p.sqf)
+	sp.SetBalances(p.balances)
 
 	// TODO: Manager properly
 	if p.lqc.MultiStore() != nil {
@@ -577,6 +626,8 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 // Clone implements libtypes.Cloneable.
 func (p *plugin) Clone() ethstate.Plugin {
 	sp := NewPlugin(p.ak, p.storeKey, p.qfn, p.plf)
+	sp.SetStoreQueryFn(p.sqf)
+	sp.SetBalances(p.balances)
 	// TODO: Manager properly
 	if p.ctx.MultiStore() != nil {
 		cacheCtx, _ := p.ctx.CacheContext()
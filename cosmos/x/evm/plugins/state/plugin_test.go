@@ -24,15 +24,22 @@ import (
 	"math/big"
 
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	testutil "github.com/berachain/polaris/cosmos/testutil"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	polarstate "github.com/berachain/polaris/eth/core/state"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -232,6 +239,34 @@ var _ = Describe("State Plugin", func() {
 			})
 		})
 
+		It("should replace the whole storage", func() {
+			sp.SetState(alice, common.Hash{3}, common.Hash{1})
+			sp.SetState(alice, common.Hash{4}, common.Hash{1})
+			sp.Finalize()
+
+			sp.SetStorage(alice, map[common.Hash]common.Hash{{4}: {2}, {5}: {2}})
+			Expect(sp.GetState(alice, common.Hash{3})).To(Equal(common.Hash{}))
+			Expect(sp.GetState(alice, common.Hash{4})).To(Equal(common.Hash{2}))
+			Expect(sp.GetState(alice, common.Hash{5})).To(Equal(common.Hash{2}))
+		})
+
+		It("should wipe the existing slots with a state override", func() {
+			sp.CreateAccount(alice)
+			sp.SetState(alice, common.Hash{3}, common.Hash{1})
+			sp.Finalize()
+			// Commit the slot, so that it is iterated from the tree of the evm store.
+			ctx.MultiStore().GetKVStore(testutil.EvmKey).(storetypes.Committer).Commit()
+			sp.Reset(ctx)
+
+			statedb := polarstate.NewStateDB(sp, ethprecompile.NewDefaultPlugin())
+			override := ethapi.StateOverride{
+				alice: {State: &map[common.Hash]common.Hash{{4}: {2}}},
+			}
+			Expect(override.Apply(statedb)).To(Succeed())
+			Expect(statedb.GetState(alice, common.Hash{3})).To(Equal(common.Hash{}))
+			Expect(statedb.GetState(alice, common.Hash{4})).To(Equal(common.Hash{2}))
+		})
+
 		Describe("TestExist", func() {
 			It("should not exist", func() {
 				Expect(sp.Exist(alice)).To(BeFalse())
@@ -465,6 +500,165 @@ var _ = Describe("State Plugin", func() {
 			})
 		})
 	})
+
+	Describe("TestStateRoot", func() {
+		It("should be the empty root without accounts", func() {
+			Expect(sp.StateRoot()).To(Equal(ethtypes.EmptyRootHash))
+		})
+
+		It("should skip empty accounts", func() {
+			sp.CreateAccount(alice)
+			Expect(sp.StateRoot()).To(Equal(ethtypes.EmptyRootHash))
+		})
+
+		It("should match the Ethereum state root", func() {
+			sp.CreateAccount(alice)
+			sp.SetBalance(alice, big.NewInt(100))
+			sp.SetNonce(alice, 2)
+			sp.CreateAccount(bob)
+			sp.SetCode(bob, []byte{1, 2, 3})
+			sp.SetState(bob, common.Hash{1}, common.Hash{2})
+			sp.SetState(bob, common.Hash{3}, common.BytesToHash([]byte{4, 5}))
+
+			genesis := &core.Genesis{
+				Config: params.TestChainConfig,
+				Alloc: core.GenesisAlloc{
+					alice: {Balance: big.NewInt(100), Nonce: 2},
+					bob: {
+						Balance: new(big.Int),
+						Code:    []byte{1, 2, 3},
+						Storage: map[common.Hash]common.Hash{
+							{1}: {2},
+							{3}: common.BytesToHash([]byte{4, 5}),
+						},
+					},
+				},
+			}
+			Expect(sp.StateRoot()).To(Equal(genesis.ToBlock().Root()))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+		})
+
+		It("should change with state", func() {
+			sp.CreateAccount(alice)
+			sp.SetBalance(alice, big.NewInt(100))
+			root := sp.StateRoot()
+
+			sp.SetState(alice, common.Hash{1}, common.Hash{2})
+			Expect(sp.StateRoot()).ToNot(Equal(root))
+
+			sp.SetState(alice, common.Hash{1}, common.Hash{})
+			Expect(sp.StateRoot()).To(Equal(root))
+		})
+
+		It("should include accounts with only a nonce", func() {
+			sp.SetNonce(alice, 1)
+
+			genesis := &core.Genesis{
+				Config: params.TestChainConfig,
+				Alloc:  core.GenesisAlloc{alice: {Balance: new(big.Int), Nonce: 1}},
+			}
+			Expect(sp.StateRoot()).To(Equal(genesis.ToBlock().Root()))
+		})
+
+		It("should invalidate the cached root on reverts", func() {
+			sp.CreateAccount(alice)
+			sp.SetBalance(alice, big.NewInt(100))
+			root := sp.StateRoot()
+			Expect(sp.StateRoot()).To(Equal(root))
+
+			snap := sp.Snapshot()
+			sp.SetState(alice, common.Hash{1}, common.Hash{2})
+			Expect(sp.StateRoot()).ToNot(Equal(root))
+
+			sp.RevertToSnapshot(snap)
+			Expect(sp.StateRoot()).To(Equal(root))
+		})
+
+		It("should keep the storage roots across blocks", func() {
+			sp.CreateAccount(bob)
+			sp.SetCode(bob, []byte{1, 2, 3})
+			sp.SetState(bob, common.Hash{1}, common.Hash{2})
+			root := sp.StateRoot()
+			sp.Finalize()
+
+			sp = state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+			sp.Reset(ctx)
+			Expect(sp.StateRoot()).To(Equal(root))
+
+			sp.SetState(bob, common.Hash{3}, common.Hash{4})
+			genesis := &core.Genesis{
+				Config: params.TestChainConfig,
+				Alloc: core.GenesisAlloc{
+					bob: {
+						Balance: new(big.Int),
+						Code:    []byte{1, 2, 3},
+						Storage: map[common.Hash]common.Hash{{1}: {2}, {3}: {4}},
+					},
+				},
+			}
+			Expect(sp.StateRoot()).To(Equal(genesis.ToBlock().Root()))
+		})
+
+		It("should drop the deleted accounts along with their trie nodes", func() {
+			// trieNodes counts the trie nodes kept in the evm store.
+			trieNodes := func() int {
+				var n int
+				it := storetypes.KVStorePrefixIterator(
+					ctx.KVStore(testutil.EvmKey), []byte{evmtypes.TrieNodeKeyPrefix},
+				)
+				defer it.Close()
+				for ; it.Valid(); it.Next() {
+					n++
+				}
+				return n
+			}
+
+			sp.SetBalance(alice, big.NewInt(100))
+			root := sp.StateRoot()
+			sp.Finalize()
+			nodes := trieNodes()
+
+			sp.Reset(ctx)
+			sp.CreateAccount(bob)
+			sp.SetCode(bob, []byte{1, 2, 3})
+			sp.SetState(bob, common.Hash{1}, common.Hash{2})
+			sp.SetState(bob, common.Hash{3}, common.Hash{4})
+			Expect(sp.StateRoot()).ToNot(Equal(root))
+			sp.Finalize()
+			Expect(trieNodes()).To(BeNumerically(">", nodes))
+
+			sp.Reset(ctx)
+			sp.DeleteAccounts([]common.Address{bob})
+			Expect(sp.StateRoot()).To(Equal(root))
+			Expect(sp.GetStorageRoot(bob)).To(Equal(ethtypes.EmptyRootHash))
+			sp.Finalize()
+			Expect(trieNodes()).To(Equal(nodes))
+			Expect(ctx.KVStore(testutil.EvmKey).Has(state.StorageRootKeyFor(bob))).To(BeFalse())
+		})
+
+		It("should index the state written before the tries were kept", func() {
+			store := ctx.KVStore(testutil.EvmKey)
+			store.Set(state.CodeHashKeyFor(bob), crypto.Keccak256([]byte{1, 2, 3}))
+			store.Set(state.SlotKeyFor(bob, common.Hash{1}), common.Hash{2}.Bytes())
+			ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, bob[:]))
+			Expect(sp.StateRoot()).To(Equal(ethtypes.EmptyRootHash))
+
+			sp = state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+			sp.IndexAccounts(ctx)
+			sp.Reset(ctx)
+			genesis := &core.Genesis{
+				Config: params.TestChainConfig,
+				Alloc: core.GenesisAlloc{
+					bob: {
+						Balance: new(big.Int),
+						Code:    []byte{1, 2, 3},
+						Storage: map[common.Hash]common.Hash{{1}: {2}},
+					},
+				},
+			}
+			Expect(sp.StateRoot()).To(Equal(genesis.ToBlock().Root()))
+		})
+	})
 })
 
 // MOCKS BELOW.
@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	ethstate "github.com/berachain/polaris/eth/core/state"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+	errorslib "github.com/berachain/polaris/lib/errors"
+	"github.com/berachain/polaris/lib/utils"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StoreQueryFn serves ABCI store queries against the committed state of the host chain, i.e.
+// `BaseApp.Query`. It is used to build the ICS23 proofs of the evm store.
+type StoreQueryFn func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+
+// SetStoreQueryFn sets the function used to query proofs of the evm store.
+func (p *plugin) SetStoreQueryFn(sqf func() StoreQueryFn) {
+	p.sqf = sqf
+}
+
+// GetProof implements `ethstate.ProofPlugin` by returning the ICS23 proof of the code hash of
+// the given account, which is the entry of the account in the evm store.
+func (p *plugin) GetProof(addr common.Address) ([][]byte, error) {
+	return p.proveKey(p.storeKey.Name(), CodeHashKeyFor(addr))
+}
+
+// GetBalanceProof implements `ethstate.ProofPlugin` by returning the ICS23 proof of the balance
+// of the given account. The balances kept in x/bank are proven by the proof of the whole units of
+// the denom in the bank store, followed by the proof of the remainder in the evm store. Other
+// balance backends return `ethstate.ErrNoBalanceProof`.
+func (p *plugin) GetBalanceProof(addr common.Address) ([][]byte, error) {
+	switch balances := p.balances.(type) {
+	case *storeBalances:
+		return p.proveKey(balances.storeKey.Name(), BalanceKeyFor(addr))
+	case *bankBalances:
+		units, err := p.proveKey(banktypes.StoreKey, bankBalanceKeyFor(addr, balances.denom))
+		if err != nil {
+			return nil, err
+		}
+		remainder, err := p.proveKey(balances.storeKey.Name(), BalanceKeyFor(addr))
+		if err != nil {
+			return nil, err
+		}
+		return append(units, remainder...), nil
+	default:
+		return nil, ethstate.ErrNoBalanceProof
+	}
+}
+
+// GetNonceProof implements `ethstate.ProofPlugin` by returning the ICS23 proof of the x/auth
+// account of the given account, of which the sequence is the nonce.
+func (p *plugin) GetNonceProof(addr common.Address) ([][]byte, error) {
+	return p.proveKey(authtypes.StoreKey, accountKeyFor(addr))
+}
+
+// GetStorageProof implements `ethstate.ProofPlugin` by returning the ICS23 proof of the given
+// storage slot of the given account.
+func (p *plugin) GetStorageProof(addr common.Address, slot common.Hash) ([][]byte, error) {
+	return p.proveKey(p.storeKey.Name(), SlotKeyFor(addr, slot))
+}
+
+// GetStorageRoot implements `ethstate.ProofPlugin` by returning the root of the storage trie of
+// the given account, as committed to by `StateRoot`.
+func (p *plugin) GetStorageRoot(addr common.Address) common.Hash {
+	// The storage trie is brought up to date with the dirty slots first.
+	store := p.cms.GetKVStore(p.storeKey)
+	root, err := p.updateStorageTrie(store, newTrieDatabase(store), addr)
+	if err != nil {
+		p.dbErr = err
+		return common.Hash{}
+	}
+	return root
+}
+
+// proveKey queries the ICS23 proof of the given key of the store with the given name, at the
+// height of the plugin's context. The proof is returned as the list of its marshaled proof
+// operations, the last of which proves the store in the multi-store.
+func (p *plugin) proveKey(storeName string, key []byte) ([][]byte, error) {
+	// Ensure the store query function is set.
+	if p.sqf == nil {
+		return nil, errors.New("no store query function set in host chain")
+	}
+
+	res, err := p.sqf()(p.ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: p.ctx.BlockHeight(),
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, errorslib.Wrap(err, "failed to query proof")
+	}
+	if res.Code != abci.CodeTypeOK {
+		return nil, fmt.Errorf("failed to query proof: %s", res.Log)
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return nil, errors.New("failed to query proof: proof is empty")
+	}
+
+	proof := make([][]byte, len(res.ProofOps.Ops))
+	for i := range res.ProofOps.Ops {
+		if proof[i], err = res.ProofOps.Ops[i].Marshal(); err != nil {
+			return nil, errorslib.Wrap(err, "failed to marshal proof")
+		}
+	}
+	return proof, nil
+}
+
+// VerifyProof verifies that the given proof, as returned by `ProofPlugin`, proves that the given
+// key of the evm store holds the given value under the given app hash. A nil value verifies that
+// the key is absent.
+//
+// NOTE: the state at height H is committed to by the app hash in the header of block H+1.
+func VerifyProof(appHash, key, value []byte, proof [][]byte) error {
+	ops, err := unmarshalProof(proof)
+	if err != nil {
+		return err
+	}
+	return verifyStoreProof(appHash, types.StoreKey, key, value, ops)
+}
+
+// VerifyAccountResult verifies all proofs of an `eth_getProof` result against the given app hash.
+// The nonce is verified against the x/auth account decoded with the given codec. The balance is
+// verified against x/bank in the denom of the given config, or against the evm store if the
+// config is nil.
+func VerifyAccountResult(
+	cdc codec.BinaryCodec, appHash []byte, res *polarapi.AccountResult, bank *BankBalancesConfig,
+) error {
+	// An account without a code hash is absent from the evm store.
+	var codeHash []byte
+	if res.CodeHash != (common.Hash{}) {
+		codeHash = res.CodeHash.Bytes()
+	}
+	ops, err := decodeProof(res.AccountProof)
+	if err == nil {
+		err = verifyStoreProof(appHash, types.StoreKey, CodeHashKeyFor(res.Address), codeHash, ops)
+	}
+	if err != nil {
+		return errorslib.Wrap(err, "invalid account proof")
+	}
+
+	if err = verifyNonceProof(cdc, appHash, res); err != nil {
+		return errorslib.Wrap(err, "invalid nonce proof")
+	}
+	if err = verifyBalanceProof(appHash, res, bank); err != nil {
+		return errorslib.Wrap(err, "invalid balance proof")
+	}
+
+	// Zero storage values are removed from the store.
+	for _, sr := range res.StorageProof {
+		// Keys are either 32 byte hashes or quantities, both of which are left padded.
+		slot := common.HexToHash(sr.Key)
+		var value []byte
+		if sr.Value.ToInt().Sign() != 0 {
+			value = common.BigToHash(sr.Value.ToInt()).Bytes()
+		}
+		if ops, err = decodeProof(sr.Proof); err == nil {
+			err = verifyStoreProof(
+				appHash, types.StoreKey, SlotKeyFor(res.Address, slot), value, ops,
+			)
+		}
+		if err != nil {
+			return errorslib.Wrapf(err, "invalid storage proof for key %s", sr.Key)
+		}
+	}
+	return nil
+}
+
+// verifyNonceProof verifies the nonce of the given result against the sequence of the x/auth
+// account, which is read from the proof. An account that is absent from x/auth has no nonce.
+func verifyNonceProof(cdc codec.BinaryCodec, appHash []byte, res *polarapi.AccountResult) error {
+	ops, err := decodeProof(res.NonceProof)
+	if err != nil {
+		return err
+	}
+	key := accountKeyFor(res.Address)
+
+	value, err := provenValue(ops)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		if res.Nonce != 0 {
+			return fmt.Errorf("nonce %d of an absent account", res.Nonce)
+		}
+		return verifyStoreProof(appHash, authtypes.StoreKey, key, nil, ops)
+	}
+	if err = verifyStoreProof(appHash, authtypes.StoreKey, key, value, ops); err != nil {
+		return err
+	}
+
+	var acc sdk.AccountI
+	if err = cdc.UnmarshalInterface(value, &acc); err != nil {
+		return errorslib.Wrap(err, "failed to unmarshal account")
+	}
+	if acc.GetSequence() != uint64(res.Nonce) {
+		return fmt.Errorf("nonce %d does not match sequence %d", res.Nonce, acc.GetSequence())
+	}
+	return nil
+}
+
+// verifyBalanceProof verifies the balance of the given result, which is either kept in the evm
+// store, or in x/bank in the denom of the given config.
+func verifyBalanceProof(
+	appHash []byte, res *polarapi.AccountResult, bank *BankBalancesConfig,
+) error {
+	ops, err := decodeProof(res.BalanceProof)
+	if err != nil {
+		return err
+	}
+	balance := res.Balance.ToInt()
+	if bank == nil {
+		return verifyBalance(appHash, types.StoreKey, BalanceKeyFor(res.Address), balance, ops)
+	}
+
+	// The proof of the whole units in x/bank is followed by the proof of the remainder in the evm
+	// store, each of which ends with the proof of its store in the multi-store.
+	split := len(ops.Ops)
+	for i, op := range ops.Ops {
+		if op.Type == storetypes.ProofOpSimpleMerkleCommitment {
+			split = i + 1
+			break
+		}
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(evmDecimals-bank.Decimals)), nil)
+	units, remainder := new(big.Int).QuoRem(balance, factor, new(big.Int))
+
+	// Zero balances are removed from x/bank.
+	var value []byte
+	if units.Sign() != 0 {
+		value, err = banktypes.BalanceValueCodec.Encode(sdkmath.NewIntFromBigInt(units))
+		if err != nil {
+			return err
+		}
+	}
+	if err = verifyStoreProof(
+		appHash, banktypes.StoreKey, bankBalanceKeyFor(res.Address, bank.Denom), value,
+		&cmtcrypto.ProofOps{Ops: ops.Ops[:split]},
+	); err != nil {
+		return err
+	}
+	return verifyBalance(
+		appHash, types.StoreKey, BalanceKeyFor(res.Address), remainder,
+		&cmtcrypto.ProofOps{Ops: ops.Ops[split:]},
+	)
+}
+
+// verifyBalance verifies the given balance of the evm store. A zero balance is either absent or
+// stored as an empty value.
+func verifyBalance(
+	appHash []byte, storeName string, key []byte, balance *big.Int, ops *cmtcrypto.ProofOps,
+) error {
+	err := verifyStoreProof(appHash, storeName, key, balance.Bytes(), ops)
+	if err != nil && balance.Sign() == 0 {
+		err = verifyStoreProof(appHash, storeName, key, nil, ops)
+	}
+	return err
+}
+
+// verifyStoreProof verifies that the given proof operations prove that the given key of the
+// store with the given name holds the given value, or is absent if the value is nil.
+func verifyStoreProof(
+	appHash []byte, storeName string, key, value []byte, ops *cmtcrypto.ProofOps,
+) error {
+	keyPath := new(merkle.KeyPath).
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+	if value == nil {
+		return rootmulti.DefaultProofRuntime().VerifyAbsence(ops, appHash, keyPath)
+	}
+	return rootmulti.DefaultProofRuntime().VerifyValue(ops, appHash, keyPath, value)
+}
+
+// provenValue returns the value that the given proof operations prove to exist in their store,
+// or nil if they prove the absence of a key.
+func provenValue(ops *cmtcrypto.ProofOps) ([]byte, error) {
+	if len(ops.Ops) == 0 {
+		return nil, errors.New("proof is empty")
+	}
+	op, err := storetypes.CommitmentOpDecoder(ops.Ops[0])
+	if err != nil {
+		return nil, err
+	}
+	return utils.MustGetAs[storetypes.CommitmentOp](op).Proof.GetExist().GetValue(), nil
+}
+
+// decodeProof decodes a proof of which the operations are hex encoded.
+func decodeProof(encoded []string) (*cmtcrypto.ProofOps, error) {
+	proof := make([][]byte, len(encoded))
+	for i, op := range encoded {
+		var err error
+		if proof[i], err = hexutil.Decode(op); err != nil {
+			return nil, err
+		}
+	}
+	return unmarshalProof(proof)
+}
+
+// unmarshalProof unmarshals the operations of a proof, as returned by `ProofPlugin`.
+func unmarshalProof(proof [][]byte) (*cmtcrypto.ProofOps, error) {
+	ops := &cmtcrypto.ProofOps{Ops: make([]cmtcrypto.ProofOp, len(proof))}
+	for i, bz := range proof {
+		if err := ops.Ops[i].Unmarshal(bz); err != nil {
+			return nil, errorslib.Wrap(err, "failed to unmarshal proof")
+		}
+	}
+	return ops, nil
+}
+
+// accountKeyFor returns the key of the x/auth account of the given address in the auth store.
+func accountKeyFor(addr common.Address) []byte {
+	bz := make([]byte, 1+common.AddressLength)
+	copy(bz, authtypes.AddressStoreKeyPrefix)
+	copy(bz[1:], addr[:])
+	return bz
+}
+
+// bankBalanceKeyFor returns the key of the x/bank balance of the given address in the given
+// denom, in the bank store.
+func bankBalanceKeyFor(addr common.Address, denom string) []byte {
+	bz := make([]byte, 2+common.AddressLength+len(denom))
+	copy(bz, banktypes.BalancesPrefix)
+	bz[1] = common.AddressLength
+	copy(bz[2:], addr[:])
+	copy(bz[2+common.AddressLength:], denom)
+	return bz
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Proofs", func() {
+	var (
+		rms     *rootmulti.Store
+		sp      state.Plugin
+		appHash []byte
+		slot    = common.Hash{1}
+		value   = common.Hash{2}
+	)
+
+	BeforeEach(func() {
+		rms = rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		rms.MountStoreWithDB(testutil.AccKey, storetypes.StoreTypeIAVL, nil)
+		rms.MountStoreWithDB(testutil.EvmKey, storetypes.StoreTypeIAVL, nil)
+		Expect(rms.LoadLatestVersion()).To(Succeed())
+
+		// Commit the state of alice at height 1.
+		store := rms.GetKVStore(testutil.EvmKey)
+		store.Set(state.CodeHashKeyFor(alice), emptyCodeHash.Bytes())
+		store.Set(state.BalanceKeyFor(alice), big.NewInt(100).Bytes())
+		store.Set(state.SlotKeyFor(alice, slot), value.Bytes())
+		store.Set(state.DirtyStorageKeyFor(alice, slot), []byte{1})
+		// The absence of a key cannot be proven in an empty store.
+		rms.GetKVStore(testutil.AccKey).Set([]byte{0}, []byte{1})
+		appHash = rms.Commit().Hash
+
+		sp = state.NewPlugin(nil, testutil.EvmKey, nil, nil)
+		sp.SetStoreQueryFn(storeQueryFn(rms))
+		sp.Reset(testutil.NewContextWithMultiStore(rms, log.NewNopLogger()).WithBlockHeight(1))
+	})
+
+	It("should prove the keys of an account", func() {
+		proof, err := sp.GetProof(alice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state.VerifyProof(
+			appHash, state.CodeHashKeyFor(alice), emptyCodeHash.Bytes(), proof,
+		)).To(Succeed())
+
+		proof, err = sp.GetBalanceProof(alice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state.VerifyProof(
+			appHash, state.BalanceKeyFor(alice), big.NewInt(100).Bytes(), proof,
+		)).To(Succeed())
+		Expect(state.VerifyProof(
+			appHash, state.BalanceKeyFor(alice), big.NewInt(101).Bytes(), proof,
+		)).ToNot(Succeed())
+
+		proof, err = sp.GetStorageProof(alice, slot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state.VerifyProof(
+			appHash, state.SlotKeyFor(alice, slot), value.Bytes(), proof,
+		)).To(Succeed())
+	})
+
+	It("should prove the absence of an account", func() {
+		proof, err := sp.GetProof(bob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state.VerifyProof(appHash, state.CodeHashKeyFor(bob), nil, proof)).To(Succeed())
+		Expect(state.VerifyProof(
+			appHash, state.CodeHashKeyFor(bob), emptyCodeHash.Bytes(), proof,
+		)).ToNot(Succeed())
+	})
+
+	It("should verify account results", func() {
+		res := &polarapi.AccountResult{
+			Address:      alice,
+			AccountProof: encode(sp.GetProof(alice)),
+			Balance:      (*hexutil.Big)(big.NewInt(100)),
+			BalanceProof: encode(sp.GetBalanceProof(alice)),
+			CodeHash:     emptyCodeHash,
+			NonceProof:   encode(sp.GetNonceProof(alice)),
+			StorageProof: []polarapi.StorageResult{
+				{
+					Key:   slot.Hex(),
+					Value: (*hexutil.Big)(value.Big()),
+					Proof: encode(sp.GetStorageProof(alice, slot)),
+				},
+				{
+					Key:   "0x1",
+					Value: new(hexutil.Big),
+					Proof: encode(sp.GetStorageProof(alice, common.BigToHash(big.NewInt(1)))),
+				},
+			},
+		}
+		Expect(state.VerifyAccountResult(cdc, appHash, res, nil)).To(Succeed())
+
+		// An account that is absent from x/auth has no nonce.
+		res.Nonce = 1
+		Expect(state.VerifyAccountResult(cdc, appHash, res, nil)).ToNot(Succeed())
+		res.Nonce = 0
+
+		res.Balance = (*hexutil.Big)(big.NewInt(1))
+		Expect(state.VerifyAccountResult(cdc, appHash, res, nil)).ToNot(Succeed())
+		res.BalanceProof = nil
+		Expect(state.VerifyAccountResult(cdc, appHash, res, nil)).ToNot(Succeed())
+	})
+
+	It("should return the storage root of an account", func() {
+		Expect(sp.GetStorageRoot(alice)).ToNot(Equal(ethtypes.EmptyRootHash))
+		Expect(sp.GetStorageRoot(bob)).To(Equal(ethtypes.EmptyRootHash))
+	})
+
+	It("should error without a store query function", func() {
+		sp.SetStoreQueryFn(nil)
+		_, err := sp.GetProof(alice)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Proofs of bank balances", func() {
+	const denom = "abera"
+
+	var (
+		rms     *rootmulti.Store
+		sp      state.Plugin
+		appHash []byte
+		bank    = &state.BankBalancesConfig{Denom: denom, Decimals: 6}
+		// balance is 5 units of the denom and a remainder of 7 wei.
+		balance = big.NewInt(5_000_000_000_007)
+	)
+
+	BeforeEach(func() {
+		_, ak, bk, _ := testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		rms = rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		rms.MountStoreWithDB(testutil.AccKey, storetypes.StoreTypeIAVL, nil)
+		rms.MountStoreWithDB(testutil.BankKey, storetypes.StoreTypeIAVL, nil)
+		rms.MountStoreWithDB(testutil.EvmKey, storetypes.StoreTypeIAVL, nil)
+		Expect(rms.LoadLatestVersion()).To(Succeed())
+		ctx := testutil.NewContextWithMultiStore(rms, log.NewNopLogger()).WithBlockHeight(1)
+		balances, err := state.NewBankBalances(
+			bk, bk.Balances.Indexes.Denom, testutil.EvmKey, *bank,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		sp = state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+		sp.SetBalances(balances)
+		sp.SetStoreQueryFn(storeQueryFn(rms))
+
+		// Commit the nonce and the balance of alice at height 1.
+		sp.Reset(ctx)
+		sp.SetNonce(alice, 3)
+		sp.AddBalance(alice, balance)
+		Expect(sp.Error()).ToNot(HaveOccurred())
+		sp.Finalize()
+		appHash = rms.Commit().Hash
+		sp.Reset(ctx)
+	})
+
+	It("should verify the nonce and the balance of an account", func() {
+		res := &polarapi.AccountResult{
+			Address:      alice,
+			AccountProof: encode(sp.GetProof(alice)),
+			Balance:      (*hexutil.Big)(balance),
+			BalanceProof: encode(sp.GetBalanceProof(alice)),
+			Nonce:        3,
+			NonceProof:   encode(sp.GetNonceProof(alice)),
+		}
+		Expect(state.VerifyAccountResult(cdc, appHash, res, bank)).To(Succeed())
+
+		res.Nonce = 2
+		Expect(state.VerifyAccountResult(cdc, appHash, res, bank)).ToNot(Succeed())
+		res.Nonce = 3
+
+		// Both the units in x/bank and the remainder in the evm store are verified.
+		res.Balance = (*hexutil.Big)(big.NewInt(4_000_000_000_007))
+		Expect(state.VerifyAccountResult(cdc, appHash, res, bank)).ToNot(Succeed())
+		res.Balance = (*hexutil.Big)(big.NewInt(5_000_000_000_008))
+		Expect(state.VerifyAccountResult(cdc, appHash, res, bank)).ToNot(Succeed())
+
+		// The balance of an account without a balance is proven to be absent.
+		res = &polarapi.AccountResult{
+			Address:      bob,
+			AccountProof: encode(sp.GetProof(bob)),
+			Balance:      new(hexutil.Big),
+			BalanceProof: encode(sp.GetBalanceProof(bob)),
+			NonceProof:   encode(sp.GetNonceProof(bob)),
+		}
+		Expect(state.VerifyAccountResult(cdc, appHash, res, bank)).To(Succeed())
+	})
+})
+
+// cdc decodes the x/auth accounts of the nonce proofs.
+var cdc = testutil.GetEncodingConfig().Codec
+
+// storeQueryFn serves the store queries of the state plugin from the given multi-store.
+func storeQueryFn(rms *rootmulti.Store) func() state.StoreQueryFn {
+	return func() state.StoreQueryFn {
+		return func(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+			res, err := rms.Query(&storetypes.RequestQuery{
+				Path:   strings.TrimPrefix(req.Path, "/store"),
+				Data:   req.Data,
+				Height: req.Height,
+				Prove:  req.Prove,
+			})
+			return (*abci.ResponseQuery)(res), err
+		}
+	}
+}
+
+// encode hex encodes the operations of the given proof.
+func encode(proof [][]byte, err error) []string {
+	Expect(err).ToNot(HaveOccurred())
+	encoded := make([]string, len(proof))
+	for i, op := range proof {
+		encoded[i] = hexutil.Encode(op)
+	}
+	return encoded
+}
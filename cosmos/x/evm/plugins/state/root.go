@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+)
+
+// errTrieNodeNotFound is returned to the trie database for the trie nodes missing in the store.
+var errTrieNodeNotFound = errors.New("trie node not found")
+
+// StateRoot implements `core.StatePlugin` by committing to the accounts of the EVM with a secure
+// Merkle-Patricia trie, shaped exactly like an Ethereum state trie. Every account that has a
+// nonce, balance, code hash or storage entry is keyed by the hash of its address and holds the RLP
+// encoding of its nonce, balance, storage root and code hash. Accounts that are empty according
+// to EIP-161 are left out of the trie.
+//
+// The account trie and the storage tries are kept in the evm store and updated incrementally.
+// Every change to an account marks it as dirty in the same store, along with the storage slots
+// that changed, so that only the dirty leaves are written to the tries. The nonces and balances
+// that are changed by other modules are marked by the multistore of `TrackAccounts`. The root is
+// also cached until the state changes, as it is requested more than once for the same block.
+//
+// NOTE: the root is computed over the current (possibly uncommitted) state of the store, so that
+// the root of a block is available before the block is committed.
+func (p *plugin) StateRoot() common.Hash {
+	if p.root != nil {
+		return *p.root
+	}
+
+	store := p.cms.GetKVStore(p.storeKey)
+	root := ethtypes.EmptyRootHash
+	if bz := store.Get([]byte{types.AccountTrieRootKey}); bz != nil {
+		root = common.BytesToHash(bz)
+	}
+
+	// The dirty accounts are collected first, so that the store is not written to while
+	// iterating.
+	var dirty []common.Address
+	p.iterate([]byte{types.DirtyAccountKeyPrefix}, func(key, _ []byte) {
+		dirty = append(dirty, common.BytesToAddress(key[1:]))
+	})
+	if len(dirty) > 0 {
+		var err error
+		if root, err = p.updateAccountTrie(store, root, dirty); err != nil {
+			p.dbErr = err
+			return common.Hash{}
+		}
+		store.Set([]byte{types.AccountTrieRootKey}, root[:])
+	}
+
+	p.root = &root
+	return root
+}
+
+// markDirty marks the given account as dirty, so that its leaf in the account trie is updated by
+// the next `StateRoot`.
+func (p *plugin) markDirty(addr common.Address) {
+	p.root = nil
+	p.cms.GetKVStore(p.storeKey).Set(DirtyAccountKeyFor(addr), []byte{1})
+}
+
+// IndexAccounts marks every account and storage slot of the EVM as dirty, so that the tries are
+// built from the whole state by the next `StateRoot`. It is used to index the state that was
+// written before the tries were kept in the evm store, or by other modules at genesis.
+func (p *plugin) IndexAccounts(ctx sdk.Context) {
+	p.Reset(ctx)
+	p.indexAccounts()
+	p.Finalize()
+}
+
+// indexAccounts marks every account and storage slot of the EVM as dirty.
+func (p *plugin) indexAccounts() {
+	store := p.cms.GetKVStore(p.storeKey)
+	p.ak.IterateAccounts(p.ctx, func(acc sdk.AccountI) bool {
+		if addr := acc.GetAddress(); len(addr) == common.AddressLength {
+			store.Set(DirtyAccountKeyFor(common.BytesToAddress(addr)), []byte{1})
+		}
+		return false
+	})
+	p.IterateBalances(common.Address{}, func(addr common.Address, _ *big.Int) bool {
+		store.Set(DirtyAccountKeyFor(addr), []byte{1})
+		return false
+	})
+
+	// The keys are collected first, so that the store is not written to while iterating.
+	var keys [][]byte
+	p.iterate([]byte{types.CodeHashKeyPrefix}, func(key, _ []byte) {
+		keys = append(keys, DirtyAccountKeyFor(AddressFromCodeHashKey(key)))
+	})
+	p.iterate([]byte{types.StorageKeyPrefix}, func(key, _ []byte) {
+		addr := AddressFromSlotKey(key)
+		keys = append(
+			keys, DirtyAccountKeyFor(addr), DirtyStorageKeyFor(addr, SlotFromSlotKey(key)),
+		)
+	})
+	for _, key := range keys {
+		store.Set(key, []byte{1})
+	}
+	p.root = nil
+}
+
+// updateAccountTrie writes the leaves of the given dirty accounts, along with their dirty storage
+// slots, to the account trie with the given root, and returns the new root.
+func (p *plugin) updateAccountTrie(
+	store storetypes.KVStore, root common.Hash, dirty []common.Address,
+) (common.Hash, error) {
+	db := newTrieDatabase(store)
+	tr, err := trie.NewStateTrie(trie.TrieID(root), db)
+	if err != nil {
+		return common.Hash{}, errorslib.Wrap(err, "failed to open account trie")
+	}
+
+	for _, addr := range dirty {
+		acc := &ethtypes.StateAccount{
+			Nonce:    p.GetNonce(addr),
+			Balance:  p.GetBalance(addr),
+			CodeHash: emptyCodeHashBytes,
+		}
+		if codeHash := store.Get(CodeHashKeyFor(addr)); codeHash != nil {
+			acc.CodeHash = codeHash
+		}
+		if acc.Root, err = p.updateStorageTrie(store, db, addr); err != nil {
+			return common.Hash{}, err
+		}
+
+		if acc.Root == ethtypes.EmptyRootHash && acc.Nonce == 0 && acc.Balance.Sign() == 0 &&
+			common.BytesToHash(acc.CodeHash) == emptyCodeHash {
+			err = tr.DeleteAccount(addr)
+		} else {
+			err = tr.UpdateAccount(addr, acc)
+		}
+		if err != nil {
+			return common.Hash{}, errorslib.Wrapf(err, "failed to update account %s", addr)
+		}
+		store.Delete(DirtyAccountKeyFor(addr))
+	}
+
+	root, nodes, err := tr.Commit(false)
+	if err != nil {
+		return common.Hash{}, errorslib.Wrap(err, "failed to commit account trie")
+	}
+	writeTrieNodes(store, nodes)
+	return root, nil
+}
+
+// updateStorageTrie writes the dirty storage slots of the given account to its storage trie, and
+// returns the new storage root.
+func (p *plugin) updateStorageTrie(
+	store storetypes.KVStore, db *trie.Database, addr common.Address,
+) (common.Hash, error) {
+	root := ethtypes.EmptyRootHash
+	if bz := store.Get(StorageRootKeyFor(addr)); bz != nil {
+		root = common.BytesToHash(bz)
+	}
+
+	// The dirty slots are collected first, so that the store is not written to while iterating.
+	var slots []common.Hash
+	p.iterate(DirtyStoragePrefixFor(addr), func(key, _ []byte) {
+		slots = append(slots, SlotFromSlotKey(key))
+	})
+	if len(slots) == 0 {
+		return root, nil
+	}
+
+	tr, err := trie.NewStateTrie(
+		trie.StorageTrieID(root, crypto.Keccak256Hash(addr[:]), root), db,
+	)
+	if err != nil {
+		return common.Hash{}, errorslib.Wrapf(err, "failed to open storage trie of %s", addr)
+	}
+	for _, slot := range slots {
+		if value := store.Get(SlotKeyFor(addr, slot)); len(value) == 0 {
+			err = tr.DeleteStorage(addr, slot[:])
+		} else {
+			err = tr.UpdateStorage(addr, slot[:], common.TrimLeftZeroes(value))
+		}
+		if err != nil {
+			return common.Hash{}, errorslib.Wrapf(err, "failed to update storage of %s", addr)
+		}
+		store.Delete(DirtyStorageKeyFor(addr, slot))
+	}
+
+	root, nodes, err := tr.Commit(false)
+	if err != nil {
+		return common.Hash{}, errorslib.Wrapf(err, "failed to commit storage trie of %s", addr)
+	}
+	writeTrieNodes(store, nodes)
+	if root == ethtypes.EmptyRootHash {
+		store.Delete(StorageRootKeyFor(addr))
+	} else {
+		store.Set(StorageRootKeyFor(addr), root[:])
+	}
+	return root, nil
+}
+
+// writeTrieNodes writes the nodes of a committed trie to the evm store. The nodes are stored by
+// hash, so that the trie database can read them, and are reference counted by the paths that
+// hold them in every trie, so that the nodes that are no longer part of any trie are deleted.
+func writeTrieNodes(store storetypes.KVStore, nodes *trienode.NodeSet) {
+	if nodes == nil {
+		return
+	}
+	nodes.ForEachWithOrder(func(path string, node *trienode.Node) {
+		key := TriePathKeyFor(nodes.Owner, []byte(path))
+		prev := store.Get(key)
+		if node.IsDeleted() {
+			store.Delete(key)
+		} else {
+			store.Set(key, node.Hash[:])
+			refTrieNode(store, node.Hash, node.Blob)
+		}
+		if prev != nil {
+			unrefTrieNode(store, common.BytesToHash(prev))
+		}
+	})
+}
+
+// refTrieNode adds a reference to the given trie node, storing it if it was not referenced yet.
+func refTrieNode(store storetypes.KVStore, hash common.Hash, blob []byte) {
+	refs := trieNodeRefs(store, hash)
+	if refs == 0 {
+		store.Set(TrieNodeKeyFor(hash), blob)
+	}
+	store.Set(TrieNodeRefKeyFor(hash), sdk.Uint64ToBigEndian(refs+1))
+}
+
+// unrefTrieNode removes a reference to the given trie node, deleting it once it is not referenced
+// anymore.
+func unrefTrieNode(store storetypes.KVStore, hash common.Hash) {
+	refs := trieNodeRefs(store, hash)
+	if refs <= 1 {
+		store.Delete(TrieNodeKeyFor(hash))
+		store.Delete(TrieNodeRefKeyFor(hash))
+		return
+	}
+	store.Set(TrieNodeRefKeyFor(hash), sdk.Uint64ToBigEndian(refs-1))
+}
+
+// trieNodeRefs returns the number of references to the given trie node.
+func trieNodeRefs(store storetypes.KVStore, hash common.Hash) uint64 {
+	bz := store.Get(TrieNodeRefKeyFor(hash))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// trieNodeReader serves the trie nodes of the evm store to a trie database, which only reads the
+// nodes by hash. The nodes are never written through the trie database, as `writeTrieNodes`
+// writes them instead.
+type trieNodeReader struct {
+	ethdb.KeyValueStore
+	store storetypes.KVStore
+}
+
+// newTrieDatabase returns a trie database that reads the trie nodes of the given evm store.
+func newTrieDatabase(store storetypes.KVStore) *trie.Database {
+	return trie.NewDatabase(
+		rawdb.NewDatabase(&trieNodeReader{KeyValueStore: memorydb.New(), store: store}), nil,
+	)
+}
+
+// Has implements `ethdb.KeyValueReader`.
+func (r *trieNodeReader) Has(key []byte) (bool, error) {
+	if len(key) != common.HashLength {
+		return false, nil
+	}
+	return r.store.Has(TrieNodeKeyFor(common.BytesToHash(key))), nil
+}
+
+// Get implements `ethdb.KeyValueReader`.
+func (r *trieNodeReader) Get(key []byte) ([]byte, error) {
+	if len(key) != common.HashLength {
+		return nil, errTrieNodeNotFound
+	}
+	blob := r.store.Get(TrieNodeKeyFor(common.BytesToHash(key)))
+	if blob == nil {
+		return nil, errTrieNodeNotFound
+	}
+	return blob, nil
+}
+
+// iterate calls fn with every key and value under the given prefix of the evm store.
+func (p *plugin) iterate(prefix []byte, fn func(key, value []byte)) {
+	it := storetypes.KVStorePrefixIterator(p.cms.GetKVStore(p.storeKey), prefix)
+	defer func() {
+		if err := it.Close(); err != nil {
+			p.dbErr = err
+		}
+	}()
+
+	for ; it.Valid(); it.Next() {
+		fn(it.Key(), it.Value())
+	}
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// trackedKeys are the store keys of the modules whose writes mark the EVM accounts as dirty.
+type trackedKeys struct {
+	evm, acc, bank storetypes.StoreKey
+}
+
+// TrackAccounts wraps the commit multistore of the app, so that every write to an account in
+// x/auth or to a balance in x/bank marks the EVM account as dirty in the evm store of the same
+// branch. It lets `StateRoot` commit to the nonces and balances that are changed by other
+// modules, without iterating over every account. The multistore must be set on the app before it
+// is loaded, with `BaseApp.SetCMS`.
+func TrackAccounts(
+	cms storetypes.CommitMultiStore, evmKey, accKey, bankKey storetypes.StoreKey,
+) storetypes.CommitMultiStore {
+	return &trackingCommitMultiStore{
+		CommitMultiStore: cms,
+		keys:             trackedKeys{evm: evmKey, acc: accKey, bank: bankKey},
+	}
+}
+
+// trackingCommitMultiStore is the commit multistore returned by `TrackAccounts`.
+type trackingCommitMultiStore struct {
+	storetypes.CommitMultiStore
+	keys trackedKeys
+}
+
+// GetStore implements `storetypes.MultiStore`.
+func (cms *trackingCommitMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore implements `storetypes.MultiStore`.
+func (cms *trackingCommitMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return cms.keys.track(cms.CommitMultiStore, key)
+}
+
+// CacheWrap implements `storetypes.CacheWrapper`.
+func (cms *trackingCommitMultiStore) CacheWrap() storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements `storetypes.CacheWrapper`.
+func (cms *trackingCommitMultiStore) CacheWrapWithTrace(
+	_ io.Writer, _ storetypes.TraceContext,
+) storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheMultiStore implements `storetypes.MultiStore`.
+func (cms *trackingCommitMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return &trackingCacheMultiStore{
+		cacheMultiStore: cms.CommitMultiStore.CacheMultiStore(), keys: cms.keys, root: cms,
+	}
+}
+
+// CacheMultiStoreWithVersion implements `storetypes.MultiStore`.
+func (cms *trackingCommitMultiStore) CacheMultiStoreWithVersion(
+	version int64,
+) (storetypes.CacheMultiStore, error) {
+	cache, err := cms.CommitMultiStore.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return &trackingCacheMultiStore{cacheMultiStore: cache, keys: cms.keys, root: cms}, nil
+}
+
+// Query implements `storetypes.Queryable`, so that the store queries of the app are still served.
+func (cms *trackingCommitMultiStore) Query(
+	req *storetypes.RequestQuery,
+) (*storetypes.ResponseQuery, error) {
+	queryable, ok := cms.CommitMultiStore.(storetypes.Queryable)
+	if !ok {
+		return nil, errors.New("multistore does not support queries")
+	}
+	return queryable.Query(req)
+}
+
+// SetCommitHeader sets the header of the block to be committed on the wrapped root multistore.
+// The app only sets it when the commit multistore is a `*rootmulti.Store`, so it is set by the
+// evm module at the end of every block instead.
+func (cms *trackingCommitMultiStore) SetCommitHeader(header cmtproto.Header) {
+	if rms, ok := cms.CommitMultiStore.(*rootmulti.Store); ok {
+		rms.SetCommitHeader(header)
+	}
+}
+
+// cacheMultiStore aliases `storetypes.CacheMultiStore`, so that it can be embedded along with the
+// `CacheMultiStore` method that wraps it.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+// trackingCacheMultiStore is a branch of the commit multistore returned by `TrackAccounts`.
+type trackingCacheMultiStore struct {
+	cacheMultiStore
+	keys trackedKeys
+	root *trackingCommitMultiStore
+}
+
+// GetStore implements `storetypes.MultiStore`.
+func (cms *trackingCacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore implements `storetypes.MultiStore`.
+func (cms *trackingCacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return cms.keys.track(cms.cacheMultiStore, key)
+}
+
+// CacheWrap implements `storetypes.CacheWrapper`.
+func (cms *trackingCacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements `storetypes.CacheWrapper`.
+func (cms *trackingCacheMultiStore) CacheWrapWithTrace(
+	_ io.Writer, _ storetypes.TraceContext,
+) storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheMultiStore implements `storetypes.MultiStore`.
+func (cms *trackingCacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return &trackingCacheMultiStore{
+		cacheMultiStore: cms.cacheMultiStore.CacheMultiStore(), keys: cms.keys, root: cms.root,
+	}
+}
+
+// CacheMultiStoreWithVersion implements `storetypes.MultiStore`.
+func (cms *trackingCacheMultiStore) CacheMultiStoreWithVersion(
+	version int64,
+) (storetypes.CacheMultiStore, error) {
+	return cms.root.CacheMultiStoreWithVersion(version)
+}
+
+// SetCommitHeader sets the header of the block to be committed on the root multistore.
+func (cms *trackingCacheMultiStore) SetCommitHeader(header cmtproto.Header) {
+	cms.root.SetCommitHeader(header)
+}
+
+// track returns the given store of the given multistore, which marks the written accounts as
+// dirty in the evm store of the multistore if it is the x/auth or x/bank store.
+func (keys trackedKeys) track(
+	ms storetypes.MultiStore, key storetypes.StoreKey,
+) storetypes.KVStore {
+	store := ms.GetKVStore(key)
+	switch key {
+	case keys.acc:
+		return &trackingStore{KVStore: store, ms: ms, evmKey: keys.evm, addressOf: accAddress}
+	case keys.bank:
+		return &trackingStore{KVStore: store, ms: ms, evmKey: keys.evm, addressOf: balanceAddress}
+	default:
+		return store
+	}
+}
+
+// trackingStore is a store that marks the accounts of the written keys as dirty in the evm store.
+type trackingStore struct {
+	storetypes.KVStore
+	ms     storetypes.MultiStore
+	evmKey storetypes.StoreKey
+
+	// addressOf returns the EVM address that the given key belongs to, if any.
+	addressOf func(key []byte) (common.Address, bool)
+}
+
+// Set implements `storetypes.KVStore`.
+func (s *trackingStore) Set(key, value []byte) {
+	s.mark(key)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements `storetypes.KVStore`.
+func (s *trackingStore) Delete(key []byte) {
+	s.mark(key)
+	s.KVStore.Delete(key)
+}
+
+// CacheWrap implements `storetypes.CacheWrapper`, so that the writes to the branch are tracked
+// once they are written back to the store.
+func (s *trackingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements `storetypes.CacheWrapper`.
+func (s *trackingStore) CacheWrapWithTrace(
+	w io.Writer, tc storetypes.TraceContext,
+) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// mark marks the account of the given key as dirty.
+func (s *trackingStore) mark(key []byte) {
+	if addr, ok := s.addressOf(key); ok {
+		s.ms.GetKVStore(s.evmKey).Set(DirtyAccountKeyFor(addr), []byte{1})
+	}
+}
+
+// accAddress returns the EVM address of an account key of x/auth, which is the prefix followed by
+// the address.
+func accAddress(key []byte) (common.Address, bool) {
+	prefix := authtypes.AddressStoreKeyPrefix.Bytes()
+	if len(key) != len(prefix)+common.AddressLength || !bytes.HasPrefix(key, prefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(key[len(prefix):]), true
+}
+
+// balanceAddress returns the EVM address of a balance key of x/bank, which is the prefix followed
+// by the length prefixed address and the denom.
+func balanceAddress(key []byte) (common.Address, bool) {
+	prefix := banktypes.BalancesPrefix.Bytes()
+	if len(key) < len(prefix)+1+common.AddressLength ||
+		!bytes.HasPrefix(key, prefix) ||
+		key[len(prefix)] != common.AddressLength {
+		return common.Address{}, false
+	}
+	start := len(prefix) + 1
+	return common.BytesToAddress(key[start : start+common.AddressLength]), true
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package state_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TrackAccounts", func() {
+	const denom = "abera"
+
+	var (
+		ctx sdk.Context
+		ak  state.AccountKeeper
+		bk  bankkeeper.BaseKeeper
+		sp  state.Plugin
+	)
+
+	BeforeEach(func() {
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		cms := state.TrackAccounts(
+			ctx.MultiStore().(storetypes.CommitMultiStore),
+			testutil.EvmKey, testutil.AccKey, testutil.BankKey,
+		)
+		ctx = ctx.WithMultiStore(cms.CacheMultiStore())
+
+		balances, err := state.NewBankBalances(
+			bk, bk.Balances.Indexes.Denom, testutil.EvmKey,
+			state.BankBalancesConfig{Denom: denom, Decimals: 18},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		sp = state.NewPlugin(ak, testutil.EvmKey, nil, &mockPLF{})
+		sp.SetBalances(balances)
+		sp.Reset(ctx)
+	})
+
+	// send sends the given amount of the denom to the given address, outside of the EVM.
+	send := func(ctx sdk.Context, addr common.Address, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(
+			ctx, evmtypes.ModuleName, addr[:], coins,
+		)).To(Succeed())
+	}
+
+	It("should mark the balances written by other modules as dirty", func() {
+		Expect(sp.StateRoot()).To(Equal(ethtypes.EmptyRootHash))
+
+		send(ctx, alice, 100)
+		store := ctx.KVStore(testutil.EvmKey)
+		Expect(store.Has(state.DirtyAccountKeyFor(alice))).To(BeTrue())
+
+		sp.Reset(ctx)
+		genesis := &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  core.GenesisAlloc{alice: {Balance: big.NewInt(100)}},
+		}
+		Expect(sp.StateRoot()).To(Equal(genesis.ToBlock().Root()))
+	})
+
+	It("should mark the accounts written in a branch once it is written", func() {
+		cacheCtx, write := ctx.CacheContext()
+		ak.SetAccount(cacheCtx, ak.NewAccountWithAddress(cacheCtx, bob[:]))
+		send(cacheCtx, bob, 1)
+		Expect(ctx.KVStore(testutil.EvmKey).Has(state.DirtyAccountKeyFor(bob))).To(BeFalse())
+
+		write()
+		Expect(ctx.KVStore(testutil.EvmKey).Has(state.DirtyAccountKeyFor(bob))).To(BeTrue())
+	})
+
+	It("should not mark the accounts of other stores", func() {
+		ctx.KVStore(testutil.StakingKey).Set(append([]byte{0x01}, alice[:]...), []byte{1})
+		Expect(ctx.KVStore(testutil.EvmKey).Has(state.DirtyAccountKeyFor(alice))).To(BeFalse())
+	})
+})
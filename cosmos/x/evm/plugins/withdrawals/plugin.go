@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawals
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// MaxWithdrawalsPerBlock is the maximum number of queued withdrawals included in a single block,
+// the remaining ones are carried over to the next blocks.
+const MaxWithdrawalsPerBlock = 16
+
+// Plugin is the interface that must be implemented by the plugin.
+type Plugin interface {
+	core.WithdrawalsPlugin
+	// QueueWithdrawal queues a credit of the given amount, in gwei, to the given address on behalf
+	// of the given validator index. The withdrawal is included in the next blocks in the order it
+	// was queued. The caller is responsible for escrowing or burning the credited funds.
+	QueueWithdrawal(
+		ctx context.Context, validator uint64, address common.Address, amount uint64,
+	) (*ethtypes.Withdrawal, error)
+}
+
+// plugin queues the withdrawals of the host chain in the evm store.
+type plugin struct {
+	// ctx is the current block context, used for accessing the withdrawals queue.
+	ctx sdk.Context
+	// storeKey is the store key of the evm store.
+	storeKey storetypes.StoreKey
+}
+
+// NewPlugin creates a new instance of the withdrawals plugin.
+func NewPlugin(storeKey storetypes.StoreKey) Plugin {
+	return &plugin{
+		storeKey: storeKey,
+	}
+}
+
+// Prepare implements core.WithdrawalsPlugin.
+func (p *plugin) Prepare(ctx context.Context) {
+	p.ctx = sdk.UnwrapSDKContext(ctx)
+}
+
+// QueueWithdrawal implements Plugin.
+func (p *plugin) QueueWithdrawal(
+	ctx context.Context, validator uint64, address common.Address, amount uint64,
+) (*ethtypes.Withdrawal, error) {
+	if amount == 0 {
+		return nil, ErrZeroAmount
+	}
+
+	store := sdk.UnwrapSDKContext(ctx).MultiStore().GetKVStore(p.storeKey)
+	index := sdk.BigEndianToUint64(store.Get([]byte{types.NextWithdrawalIndexKey}))
+	withdrawal := &ethtypes.Withdrawal{
+		Index:     index,
+		Validator: validator,
+		Address:   address,
+		Amount:    amount,
+	}
+
+	bz, err := rlp.EncodeToBytes(withdrawal)
+	if err != nil {
+		return nil, errorslib.Wrap(err, "QueueWithdrawal: failed to encode withdrawal")
+	}
+	prefix.NewStore(store, []byte{types.WithdrawalKeyPrefix}).
+		Set(sdk.Uint64ToBigEndian(index), bz)
+	store.Set([]byte{types.NextWithdrawalIndexKey}, sdk.Uint64ToBigEndian(index+1))
+	return withdrawal, nil
+}
+
+// PendingWithdrawals implements core.WithdrawalsPlugin.
+func (p *plugin) PendingWithdrawals() (ethtypes.Withdrawals, error) {
+	iter := prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
+		[]byte{types.WithdrawalKeyPrefix}).Iterator(nil, nil)
+	defer iter.Close()
+
+	withdrawals := make(ethtypes.Withdrawals, 0)
+	for ; iter.Valid() && len(withdrawals) < MaxWithdrawalsPerBlock; iter.Next() {
+		withdrawal := new(ethtypes.Withdrawal)
+		if err := rlp.DecodeBytes(iter.Value(), withdrawal); err != nil {
+			return nil, errorslib.Wrap(err, "PendingWithdrawals: failed to decode withdrawal")
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals, nil
+}
+
+// ProcessWithdrawals implements core.WithdrawalsPlugin.
+func (p *plugin) ProcessWithdrawals(withdrawals ethtypes.Withdrawals) error {
+	store := prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
+		[]byte{types.WithdrawalKeyPrefix})
+	for _, withdrawal := range withdrawals {
+		key := sdk.Uint64ToBigEndian(withdrawal.Index)
+		if !store.Has(key) {
+			return errorslib.Wrapf(ErrWithdrawalNotQueued, "index %d", withdrawal.Index)
+		}
+		store.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawals
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/lib/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestWithdrawalsPlugin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/plugins/withdrawals")
+}
+
+var _ = Describe("Withdrawals", func() {
+	var (
+		p   *plugin
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext(log.NewTestLogger(GinkgoT()))
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey))
+		p.Prepare(ctx)
+	})
+
+	It("should have no pending withdrawals by default", func() {
+		withdrawals, err := p.PendingWithdrawals()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withdrawals).To(BeEmpty())
+	})
+
+	It("should reject zero amounts", func() {
+		_, err := p.QueueWithdrawal(ctx, 0, common.Address{0x1}, 0)
+		Expect(err).To(MatchError(ErrZeroAmount))
+	})
+
+	It("should queue and dequeue withdrawals in order", func() {
+		first, err := p.QueueWithdrawal(ctx, 1, common.Address{0x1}, 100)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := p.QueueWithdrawal(ctx, 2, common.Address{0x2}, 200)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first.Index).To(Equal(uint64(0)))
+		Expect(second.Index).To(Equal(uint64(1)))
+
+		withdrawals, err := p.PendingWithdrawals()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withdrawals).To(Equal(ethtypes.Withdrawals{first, second}))
+
+		Expect(p.ProcessWithdrawals(ethtypes.Withdrawals{first})).To(Succeed())
+		withdrawals, err = p.PendingWithdrawals()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withdrawals).To(Equal(ethtypes.Withdrawals{second}))
+
+		Expect(p.ProcessWithdrawals(ethtypes.Withdrawals{first})).
+			To(MatchError(ContainSubstring(ErrWithdrawalNotQueued.Error())))
+
+		// indexes are never reused once dequeued.
+		third, err := p.QueueWithdrawal(ctx, 3, common.Address{0x3}, 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(third.Index).To(Equal(uint64(2)))
+	})
+
+	It("should cap the withdrawals of a block", func() {
+		for i := 0; i < MaxWithdrawalsPerBlock+1; i++ {
+			_, err := p.QueueWithdrawal(ctx, 0, common.Address{0x1}, 1)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		withdrawals, err := p.PendingWithdrawals()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withdrawals).To(HaveLen(MaxWithdrawalsPerBlock))
+	})
+})
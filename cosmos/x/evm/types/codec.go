@@ -32,6 +32,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&WrappedEthereumTransaction{},
 		&WrappedPayloadEnvelope{},
+		&MsgUpdatePrecompileGas{},
+		&MsgCreatePrecompile{},
+		&MsgSetPrecompilePaused{},
+		&MsgRemovePrecompile{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
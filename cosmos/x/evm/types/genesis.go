@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/berachain/polaris/eth/core"
+	polarparams "github.com/berachain/polaris/eth/params"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// precompilesJSONKey is the key of the precompile state in the JSON of the evm genesis state.
+const precompilesJSONKey = "precompiles"
+
+// GenesisState is the genesis state of the evm module. It is the Ethereum genesis, extended with
+// the precompile state that the host chain keeps in the evm store.
+type GenesisState struct {
+	*core.Genesis
+	// Precompiles is the precompile state, which is omitted from the JSON if it is empty.
+	Precompiles *PrecompileGenesis
+}
+
+// PrecompileGenesis is the precompile state that governance sets in the evm store.
+type PrecompileGenesis struct {
+	// Dynamic are the instances of the dynamic precompiles.
+	Dynamic []DynamicPrecompile `json:"dynamic,omitempty"`
+	// MethodBaseGas are the base gas overrides of the precompile methods.
+	MethodBaseGas []MethodBaseGas `json:"methodBaseGas,omitempty"`
+}
+
+// DynamicPrecompile is an instance of a dynamic precompile.
+type DynamicPrecompile struct {
+	Address common.Address `json:"address"`
+	Kind    string         `json:"kind"`
+	Args    string         `json:"args"`
+	Paused  bool           `json:"paused,omitempty"`
+}
+
+// MethodBaseGas is the base gas override of a precompile method.
+type MethodBaseGas struct {
+	Precompile common.Address `json:"precompile"`
+	Method     string         `json:"method"`
+	BaseGas    uint64         `json:"baseGas"`
+}
+
+// IsEmpty returns whether the precompile state is empty.
+func (pg *PrecompileGenesis) IsEmpty() bool {
+	return pg == nil || (len(pg.Dynamic) == 0 && len(pg.MethodBaseGas) == 0)
+}
+
+// MarshalJSON marshals the Ethereum genesis, with the precompile state under the "precompiles"
+// key, if it is not empty.
+func (gs GenesisState) MarshalJSON() ([]byte, error) {
+	bz, err := gs.Genesis.MarshalJSON()
+	if err != nil || gs.Precompiles.IsEmpty() {
+		return bz, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if fields[precompilesJSONKey], err = json.Marshal(gs.Precompiles); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON unmarshals the Ethereum genesis and the precompile state, if any.
+func (gs *GenesisState) UnmarshalJSON(bz []byte) error {
+	gs.Genesis = new(core.Genesis)
+	if err := gs.Genesis.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	var fields struct {
+		Precompiles *PrecompileGenesis `json:"precompiles"`
+	}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	gs.Precompiles = fields.Precompiles
+	return nil
+}
+
+// Validate performs a stateless validation of the precompile state. The instances and the base
+// gas overrides must be unique, and the base gas overrides must name a method.
+func (pg *PrecompileGenesis) Validate() error {
+	if pg == nil {
+		return nil
+	}
+
+	var errs []error
+	instances := make(map[common.Address]struct{}, len(pg.Dynamic))
+	for _, dp := range pg.Dynamic {
+		if _, ok := instances[dp.Address]; ok {
+			errs = append(errs, fmt.Errorf("dynamic precompile %s: duplicate", dp.Address.Hex()))
+		}
+		instances[dp.Address] = struct{}{}
+		if dp.Kind == "" {
+			errs = append(errs, fmt.Errorf("dynamic precompile %s: empty kind", dp.Address.Hex()))
+		}
+	}
+
+	type method struct {
+		precompile common.Address
+		name       string
+	}
+	methods := make(map[method]struct{}, len(pg.MethodBaseGas))
+	for _, mbg := range pg.MethodBaseGas {
+		m := method{mbg.Precompile, mbg.Method}
+		if _, ok := methods[m]; ok {
+			errs = append(errs, fmt.Errorf(
+				"base gas %s: duplicate method %q", mbg.Precompile.Hex(), mbg.Method,
+			))
+		}
+		methods[m] = struct{}{}
+		if mbg.Method == "" {
+			errs = append(errs, fmt.Errorf("base gas %s: empty method", mbg.Precompile.Hex()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateGenesis performs a stateless validation of the given evm genesis state. The allocs may
+// not be placed at the given precompile addresses nor at any precompile activated by the chain
+// config at genesis. Every problem found is reported, joined into the returned error.
+func ValidateGenesis(gen *core.Genesis, precompiles []common.Address) error {
+	var errs []error
+
+	// A genesis without a chain config runs with the chain config of the node.
+	config := gen.Config
+	if config == nil {
+		config = polarparams.DefaultChainConfig
+	} else if err := config.CheckConfigForkOrder(); err != nil {
+		errs = append(errs, fmt.Errorf("chain config: %w", err))
+	}
+
+	errs = append(errs, validateGenesisHeader(gen, config)...)
+
+	// Sort the addresses so that the problems are reported in a consistent order.
+	addresses := make([]common.Address, 0, len(gen.Alloc))
+	for address := range gen.Alloc {
+		addresses = append(addresses, address)
+	}
+	slices.SortStableFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+	rules := config.Rules(new(big.Int), true, gen.Timestamp)
+	reserved := make(map[common.Address]struct{})
+	for _, address := range append(vm.ActivePrecompiles(nil, rules), precompiles...) {
+		reserved[address] = struct{}{}
+	}
+	for _, address := range addresses {
+		errs = append(errs, validateGenesisAccount(address, gen.Alloc[address], reserved)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGenesisNonces checks that the nonces of the evm genesis allocs match the sequences of
+// the given accounts of the auth genesis state.
+func ValidateGenesisNonces(gen *core.Genesis, sequences map[common.Address]uint64) error {
+	addresses := make([]common.Address, 0, len(gen.Alloc))
+	for address := range gen.Alloc {
+		addresses = append(addresses, address)
+	}
+	slices.SortStableFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+	var errs []error
+	for _, address := range addresses {
+		sequence, ok := sequences[address]
+		if nonce := gen.Alloc[address].Nonce; ok && sequence != nonce {
+			errs = append(errs, fmt.Errorf(
+				"alloc %s: nonce %d does not match the auth genesis sequence %d",
+				address.Hex(), nonce, sequence,
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateGenesisHeader checks the gas limit, base fee and header fields of the genesis block.
+func validateGenesisHeader(gen *core.Genesis, config *params.ChainConfig) []error {
+	var errs []error
+	if gen.Number != 0 {
+		errs = append(errs, fmt.Errorf("genesis number must be 0, got %d", gen.Number))
+	}
+	if gen.GasLimit < params.MinGasLimit {
+		errs = append(errs, fmt.Errorf(
+			"gas limit %d is below the minimum of %d", gen.GasLimit, params.MinGasLimit,
+		))
+	}
+	if gen.GasLimit > params.MaxGasLimit {
+		errs = append(errs, fmt.Errorf(
+			"gas limit %d is above the maximum of %d", gen.GasLimit, params.MaxGasLimit,
+		))
+	}
+	if gen.GasUsed > gen.GasLimit {
+		errs = append(errs, fmt.Errorf(
+			"gas used %d is above the gas limit %d", gen.GasUsed, gen.GasLimit,
+		))
+	}
+	if len(gen.ExtraData) > int(params.MaximumExtraDataSize) {
+		errs = append(errs, fmt.Errorf(
+			"extra data size %d is above the maximum of %d",
+			len(gen.ExtraData), params.MaximumExtraDataSize,
+		))
+	}
+
+	if gen.BaseFee != nil {
+		switch {
+		case !config.IsLondon(new(big.Int)):
+			errs = append(errs, fmt.Errorf(
+				"base fee %s is set but london is not active at genesis", gen.BaseFee,
+			))
+		case gen.BaseFee.Sign() <= 0:
+			errs = append(errs, fmt.Errorf("base fee %s must be positive", gen.BaseFee))
+		case !gen.BaseFee.IsUint64():
+			errs = append(errs, fmt.Errorf("base fee %s does not fit in 64 bits", gen.BaseFee))
+		}
+	}
+	return errs
+}
+
+// validateGenesisAccount checks the sanity of the genesis alloc at the given address.
+func validateGenesisAccount(
+	address common.Address, account core.GenesisAccount, reserved map[common.Address]struct{},
+) []error {
+	var errs []error
+	if _, ok := reserved[address]; ok {
+		errs = append(errs, fmt.Errorf("alloc %s: address is a precompile", address.Hex()))
+	}
+	if account.Balance != nil && account.Balance.Sign() < 0 {
+		errs = append(errs, fmt.Errorf(
+			"alloc %s: balance %s is negative", address.Hex(), account.Balance,
+		))
+	}
+	if len(account.Code) > params.MaxCodeSize {
+		errs = append(errs, fmt.Errorf(
+			"alloc %s: code size %d is above the maximum of %d",
+			address.Hex(), len(account.Code), params.MaxCodeSize,
+		))
+	}
+	if len(account.Storage) > 0 && len(account.Code) == 0 {
+		errs = append(errs, fmt.Errorf("alloc %s: storage is set without code", address.Hex()))
+	}
+	return errs
+}
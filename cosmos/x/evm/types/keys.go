@@ -41,4 +41,17 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	StateRootKeyPrefix
+	WithdrawalKeyPrefix
+	NextWithdrawalIndexKey
+	FractionalBalanceSupplyKey
+	PrecompileBaseGasKeyPrefix
+	DynamicPrecompileKeyPrefix
+	StorageRootKeyPrefix
+	DirtyStorageKeyPrefix
+	DirtyAccountKeyPrefix
+	AccountTrieRootKey
+	TrieNodeKeyPrefix
+	TrieNodeRefKeyPrefix
+	TriePathKeyPrefix
 )
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ParentBeaconRoot returns the EIP-4788 parent beacon block root of the block built in the given
+// context, which is the app hash committed to by the Comet block at the context's height. The
+// proposal contexts of the initial height carry no header while the finalize context carries the
+// hash of empty bytes, so an empty app hash is read as the latter on both paths.
+func ParentBeaconRoot(ctx sdk.Context) common.Hash {
+	appHash := ctx.BlockHeader().AppHash
+	if len(appHash) == 0 {
+		emptyHash := sha256.Sum256([]byte{})
+		appHash = emptyHash[:]
+	}
+	return common.BytesToHash(appHash)
+}
+
+// VersionedHashes returns the EIP-4844 versioned hashes of the blob commitments in the blobs
+// bundle of the given envelope.
+func VersionedHashes(envelope *engine.ExecutionPayloadEnvelope) []common.Hash {
+	if envelope.BlobsBundle == nil {
+		return []common.Hash{}
+	}
+
+	hashes := make([]common.Hash, len(envelope.BlobsBundle.Commitments))
+	for i, commitment := range envelope.BlobsBundle.Commitments {
+		hashes[i] = sha256.Sum256(commitment)
+		hashes[i][0] = params.BlobTxHashVersion
+	}
+	return hashes
+}
+
+// ExecutableDataToBlock converts the execution payload of the given envelope to a block. The
+// versioned hashes of the blobs bundle must match the blob transactions of the payload and, once
+// Cancun is active, the block commits to the parent beacon root of the given context.
+func ExecutableDataToBlock(
+	ctx sdk.Context, config *params.ChainConfig, envelope *engine.ExecutionPayloadEnvelope,
+) (*ethtypes.Block, error) {
+	var (
+		payload    = envelope.ExecutionPayload
+		beaconRoot *common.Hash
+	)
+	if config.IsCancun(new(big.Int).SetUint64(payload.Number), payload.Timestamp) {
+		root := ParentBeaconRoot(ctx)
+		beaconRoot = &root
+	}
+	return engine.ExecutableDataToBlock(*payload, VersionedHashes(envelope), beaconRoot)
+}
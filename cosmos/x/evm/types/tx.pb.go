@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -194,37 +195,481 @@ func (m *WrappedEthereumTransactionResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WrappedEthereumTransactionResult proto.InternalMessageInfo
 
+// MsgUpdatePrecompileGas sets the base gas of a stateful precompile method, which overrides the
+// base gas of the gas schedule declared by the precompile.
+type MsgUpdatePrecompileGas struct {
+	// authority is the address that controls the precompile gas schedules (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// precompile is the hex address of the precompile.
+	Precompile string `protobuf:"bytes,2,opt,name=precompile,proto3" json:"precompile,omitempty"`
+	// method is the name of the precompile method, or `receive` and `fallback` for the special
+	// functions.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// base_gas is the gas charged for every call to the method.
+	BaseGas uint64 `protobuf:"varint,4,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+}
+
+func (m *MsgUpdatePrecompileGas) Reset()         { *m = MsgUpdatePrecompileGas{} }
+func (m *MsgUpdatePrecompileGas) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileGas) ProtoMessage()    {}
+func (*MsgUpdatePrecompileGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{4}
+}
+func (m *MsgUpdatePrecompileGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileGas.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileGas proto.InternalMessageInfo
+
+func (m *MsgUpdatePrecompileGas) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileGas) GetPrecompile() string {
+	if m != nil {
+		return m.Precompile
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileGas) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileGas) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+// MsgUpdatePrecompileGasResponse defines the Msg/UpdatePrecompileGas response type.
+type MsgUpdatePrecompileGasResponse struct {
+}
+
+func (m *MsgUpdatePrecompileGasResponse) Reset()         { *m = MsgUpdatePrecompileGasResponse{} }
+func (m *MsgUpdatePrecompileGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileGasResponse) ProtoMessage()    {}
+func (*MsgUpdatePrecompileGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{5}
+}
+func (m *MsgUpdatePrecompileGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileGasResponse.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileGasResponse proto.InternalMessageInfo
+
+// MsgCreatePrecompile instantiates a dynamic precompile of a registered kind, which is deployed at
+// an address derived from the kind and the arguments.
+type MsgCreatePrecompile struct {
+	// authority is the address that controls the dynamic precompiles (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// kind is the kind of the dynamic precompile.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// args are the arguments that the precompile is built from (e.g. a bank denom).
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *MsgCreatePrecompile) Reset()         { *m = MsgCreatePrecompile{} }
+func (m *MsgCreatePrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrecompile) ProtoMessage()    {}
+func (*MsgCreatePrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{6}
+}
+func (m *MsgCreatePrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrecompile.Merge(m, src)
+}
+func (m *MsgCreatePrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePrecompile proto.InternalMessageInfo
+
+func (m *MsgCreatePrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreatePrecompile) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *MsgCreatePrecompile) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+// MsgCreatePrecompileResponse defines the Msg/CreatePrecompile response type.
+type MsgCreatePrecompileResponse struct {
+	// address is the hex address of the new precompile.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCreatePrecompileResponse) Reset()         { *m = MsgCreatePrecompileResponse{} }
+func (m *MsgCreatePrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrecompileResponse) ProtoMessage()    {}
+func (*MsgCreatePrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{7}
+}
+func (m *MsgCreatePrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrecompileResponse.Merge(m, src)
+}
+func (m *MsgCreatePrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePrecompileResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePrecompileResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgSetPrecompilePaused pauses or resumes a dynamic precompile. The calls to a paused precompile
+// revert.
+type MsgSetPrecompilePaused struct {
+	// authority is the address that controls the dynamic precompiles (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// precompile is the hex address of the dynamic precompile.
+	Precompile string `protobuf:"bytes,2,opt,name=precompile,proto3" json:"precompile,omitempty"`
+	// paused is true to pause the precompile and false to resume it.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetPrecompilePaused) Reset()         { *m = MsgSetPrecompilePaused{} }
+func (m *MsgSetPrecompilePaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrecompilePaused) ProtoMessage()    {}
+func (*MsgSetPrecompilePaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{8}
+}
+func (m *MsgSetPrecompilePaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPrecompilePaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrecompilePaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPrecompilePaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrecompilePaused.Merge(m, src)
+}
+func (m *MsgSetPrecompilePaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrecompilePaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrecompilePaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPrecompilePaused proto.InternalMessageInfo
+
+func (m *MsgSetPrecompilePaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPrecompilePaused) GetPrecompile() string {
+	if m != nil {
+		return m.Precompile
+	}
+	return ""
+}
+
+func (m *MsgSetPrecompilePaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetPrecompilePausedResponse defines the Msg/SetPrecompilePaused response type.
+type MsgSetPrecompilePausedResponse struct {
+}
+
+func (m *MsgSetPrecompilePausedResponse) Reset()         { *m = MsgSetPrecompilePausedResponse{} }
+func (m *MsgSetPrecompilePausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrecompilePausedResponse) ProtoMessage()    {}
+func (*MsgSetPrecompilePausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{9}
+}
+func (m *MsgSetPrecompilePausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPrecompilePausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrecompilePausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPrecompilePausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrecompilePausedResponse.Merge(m, src)
+}
+func (m *MsgSetPrecompilePausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrecompilePausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrecompilePausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPrecompilePausedResponse proto.InternalMessageInfo
+
+// MsgRemovePrecompile removes a dynamic precompile.
+type MsgRemovePrecompile struct {
+	// authority is the address that controls the dynamic precompiles (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// precompile is the hex address of the dynamic precompile.
+	Precompile string `protobuf:"bytes,2,opt,name=precompile,proto3" json:"precompile,omitempty"`
+}
+
+func (m *MsgRemovePrecompile) Reset()         { *m = MsgRemovePrecompile{} }
+func (m *MsgRemovePrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePrecompile) ProtoMessage()    {}
+func (*MsgRemovePrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{10}
+}
+func (m *MsgRemovePrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePrecompile.Merge(m, src)
+}
+func (m *MsgRemovePrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePrecompile proto.InternalMessageInfo
+
+func (m *MsgRemovePrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemovePrecompile) GetPrecompile() string {
+	if m != nil {
+		return m.Precompile
+	}
+	return ""
+}
+
+// MsgRemovePrecompileResponse defines the Msg/RemovePrecompile response type.
+type MsgRemovePrecompileResponse struct {
+}
+
+func (m *MsgRemovePrecompileResponse) Reset()         { *m = MsgRemovePrecompileResponse{} }
+func (m *MsgRemovePrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePrecompileResponse) ProtoMessage()    {}
+func (*MsgRemovePrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{11}
+}
+func (m *MsgRemovePrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePrecompileResponse.Merge(m, src)
+}
+func (m *MsgRemovePrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePrecompileResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedPayloadEnvelope)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelope")
 	proto.RegisterType((*WrappedPayloadEnvelopeResponse)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelopeResponse")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgUpdatePrecompileGas)(nil), "polaris.evm.v1alpha1.MsgUpdatePrecompileGas")
+	proto.RegisterType((*MsgUpdatePrecompileGasResponse)(nil), "polaris.evm.v1alpha1.MsgUpdatePrecompileGasResponse")
+	proto.RegisterType((*MsgCreatePrecompile)(nil), "polaris.evm.v1alpha1.MsgCreatePrecompile")
+	proto.RegisterType((*MsgCreatePrecompileResponse)(nil), "polaris.evm.v1alpha1.MsgCreatePrecompileResponse")
+	proto.RegisterType((*MsgSetPrecompilePaused)(nil), "polaris.evm.v1alpha1.MsgSetPrecompilePaused")
+	proto.RegisterType((*MsgSetPrecompilePausedResponse)(nil), "polaris.evm.v1alpha1.MsgSetPrecompilePausedResponse")
+	proto.RegisterType((*MsgRemovePrecompile)(nil), "polaris.evm.v1alpha1.MsgRemovePrecompile")
+	proto.RegisterType((*MsgRemovePrecompileResponse)(nil), "polaris.evm.v1alpha1.MsgRemovePrecompileResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0x3b, 0x31,
-	0x10, 0xc7, 0xbb, 0x3f, 0x7e, 0x7a, 0x08, 0xe2, 0x61, 0x91, 0x2a, 0x0b, 0x86, 0xb2, 0x27, 0x91,
-	0x92, 0xb4, 0x2a, 0x3e, 0x80, 0xd0, 0x83, 0x07, 0xa1, 0x54, 0x41, 0xf0, 0x36, 0x4d, 0x87, 0xee,
-	0xc2, 0x66, 0x13, 0x32, 0x69, 0x68, 0xc5, 0x83, 0x2f, 0x20, 0xf8, 0x28, 0x3e, 0x86, 0xc7, 0x1e,
-	0x3d, 0x4a, 0x7b, 0xf0, 0x35, 0xc4, 0xfe, 0x01, 0x29, 0xad, 0xd0, 0x53, 0x02, 0xf3, 0x99, 0xe1,
-	0x3b, 0xc3, 0x87, 0x1d, 0x5b, 0x53, 0x80, 0xcb, 0x49, 0x62, 0xd0, 0x32, 0x34, 0xa1, 0xb0, 0x19,
-	0x34, 0xa5, 0x1f, 0x0a, 0xeb, 0x8c, 0x37, 0xf1, 0xc1, 0xa2, 0x2c, 0x30, 0x68, 0xb1, 0x2c, 0x27,
-	0x87, 0xca, 0x90, 0x36, 0x24, 0x35, 0xf5, 0x65, 0x68, 0xfe, 0x3c, 0x73, 0x3c, 0x6d, 0xb0, 0xe4,
-	0xde, 0x81, 0xb5, 0xd8, 0x6b, 0xf9, 0x0c, 0x1d, 0x0e, 0xf4, 0x9d, 0x83, 0x92, 0x40, 0xf9, 0xdc,
-	0x94, 0x71, 0xcc, 0xfe, 0xf7, 0xc0, 0xc3, 0x51, 0x54, 0x8b, 0x4e, 0xf6, 0x3a, 0xb3, 0x7f, 0x5a,
-	0x67, 0xd5, 0x45, 0x47, 0x1b, 0x46, 0x85, 0x81, 0x5e, 0xab, 0x0c, 0x58, 0x18, 0x8b, 0x6b, 0xe9,
-	0x1a, 0xe3, 0xeb, 0xe9, 0x0e, 0x92, 0x35, 0x25, 0x61, 0x9a, 0xb2, 0xda, 0xe6, 0x04, 0x1d, 0xa4,
-	0x41, 0xe1, 0xcf, 0x5e, 0xfe, 0x31, 0x76, 0x43, 0xfd, 0x5b, 0x74, 0x21, 0x57, 0x18, 0x3f, 0xb2,
-	0xfd, 0x96, 0xcf, 0x7e, 0x07, 0x6d, 0x88, 0x75, 0x6b, 0x8b, 0xcd, 0x83, 0x93, 0xcb, 0x6d, 0x3b,
-	0xe6, 0x51, 0xe2, 0x27, 0x56, 0x6d, 0x3b, 0xa3, 0x90, 0x68, 0x75, 0xfd, 0xfa, 0x9f, 0x13, 0x57,
-	0xe8, 0xe4, 0x62, 0x1b, 0x7a, 0x79, 0xac, 0x64, 0xe7, 0xf9, 0xeb, 0xed, 0x34, 0xba, 0xba, 0x7e,
-	0x9f, 0xf0, 0x68, 0x3c, 0xe1, 0xd1, 0xe7, 0x84, 0x47, 0xaf, 0x53, 0x5e, 0x19, 0x4f, 0x79, 0xe5,
-	0x63, 0xca, 0x2b, 0x0f, 0xb2, 0x9f, 0xfb, 0x6c, 0xd0, 0x15, 0xca, 0x68, 0xd9, 0x45, 0x07, 0x2a,
-	0x83, 0xbc, 0x94, 0x4b, 0x65, 0x16, 0x16, 0x0c, 0x67, 0xee, 0xf8, 0x91, 0x45, 0xea, 0xee, 0xce,
-	0x3c, 0x38, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x9f, 0x14, 0xe7, 0x57, 0x02, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6b, 0xd4, 0x40,
+	0x18, 0x6d, 0x74, 0xfb, 0x6b, 0x90, 0x22, 0x69, 0x59, 0xd3, 0x94, 0x86, 0x25, 0xa7, 0x5a, 0x6a,
+	0xd2, 0xd5, 0x52, 0xc1, 0x9b, 0x95, 0x52, 0x3c, 0x14, 0x96, 0x54, 0x11, 0xbc, 0x94, 0xd9, 0xe4,
+	0x23, 0x09, 0x26, 0x99, 0x61, 0x66, 0x36, 0x74, 0x45, 0x41, 0x04, 0xef, 0x1e, 0xfd, 0x33, 0x0a,
+	0xfa, 0x47, 0x78, 0x2c, 0x9e, 0x3c, 0xca, 0xee, 0x61, 0xff, 0x0d, 0xd9, 0x49, 0xb2, 0xbb, 0xee,
+	0x26, 0xda, 0x15, 0x3d, 0xed, 0x7c, 0xf9, 0xde, 0xcc, 0x7b, 0x6f, 0xb2, 0x2f, 0x1f, 0xda, 0xa6,
+	0x24, 0xc2, 0x2c, 0xe4, 0x36, 0xa4, 0xb1, 0x9d, 0x36, 0x71, 0x44, 0x03, 0xdc, 0xb4, 0xc5, 0x85,
+	0x45, 0x19, 0x11, 0x44, 0xdd, 0xc8, 0xdb, 0x16, 0xa4, 0xb1, 0x55, 0xb4, 0xf5, 0x3b, 0x2e, 0xe1,
+	0x31, 0xe1, 0x76, 0xcc, 0x7d, 0x3b, 0x6d, 0x0e, 0x7f, 0x32, 0xb8, 0xbe, 0x99, 0x35, 0xce, 0x65,
+	0x65, 0x67, 0x45, 0xd6, 0x32, 0xf7, 0x91, 0xfe, 0x82, 0x61, 0x4a, 0xc1, 0x3b, 0x16, 0x01, 0x30,
+	0xe8, 0xc4, 0xcf, 0x18, 0x4e, 0x38, 0x76, 0x45, 0x48, 0x12, 0x55, 0x45, 0x35, 0x0f, 0x0b, 0xac,
+	0x29, 0x0d, 0x65, 0xe7, 0x96, 0x23, 0xd7, 0xe6, 0x1e, 0xaa, 0xe7, 0x3b, 0x5a, 0xb8, 0x1b, 0x11,
+	0xec, 0x1d, 0x27, 0x29, 0x44, 0x84, 0x42, 0x29, 0xba, 0x81, 0x8c, 0x72, 0xb4, 0x03, 0x9c, 0x92,
+	0x84, 0x83, 0x69, 0xa2, 0x46, 0xb5, 0x02, 0x07, 0x78, 0x27, 0x12, 0xe6, 0x67, 0x05, 0xd5, 0x4f,
+	0xb9, 0xff, 0x9c, 0x7a, 0x58, 0x40, 0x8b, 0x81, 0x4b, 0x62, 0x1a, 0x46, 0x70, 0x82, 0xb9, 0x7a,
+	0x88, 0x56, 0x71, 0x47, 0x04, 0x84, 0x85, 0xa2, 0x2b, 0x99, 0x57, 0x8f, 0xb4, 0x6f, 0x5f, 0xee,
+	0x6d, 0xe4, 0x2e, 0x1f, 0x7b, 0x1e, 0x03, 0xce, 0xcf, 0x04, 0x0b, 0x13, 0xdf, 0x19, 0x43, 0x55,
+	0x03, 0x21, 0x3a, 0x3a, 0x48, 0xbb, 0x31, 0xdc, 0xe8, 0x4c, 0x3c, 0x51, 0xeb, 0x68, 0x29, 0x06,
+	0x11, 0x10, 0x4f, 0xbb, 0x29, 0x7b, 0x79, 0xa5, 0x6e, 0xa2, 0x95, 0x36, 0xe6, 0x70, 0xee, 0x63,
+	0xae, 0xd5, 0x1a, 0xca, 0x4e, 0xcd, 0x59, 0x1e, 0xd6, 0x27, 0x98, 0x3f, 0x5a, 0x7b, 0x3f, 0xb8,
+	0xdc, 0x1d, 0x53, 0x0c, 0xbd, 0x97, 0x8b, 0x1e, 0x79, 0xff, 0xa0, 0xa0, 0xf5, 0x53, 0xee, 0x3f,
+	0x61, 0xf0, 0x0b, 0xe4, 0xaf, 0x4d, 0xa9, 0xa8, 0xf6, 0x2a, 0x4c, 0xbc, 0xdc, 0x8e, 0x5c, 0x0f,
+	0x9f, 0x61, 0xe6, 0xf3, 0xdc, 0x86, 0x5c, 0xcf, 0x28, 0x7d, 0x88, 0xb6, 0x4a, 0x64, 0x14, 0x32,
+	0x55, 0x0d, 0x2d, 0xe3, 0x8c, 0x32, 0x13, 0xe3, 0x14, 0xa5, 0xf9, 0x29, 0x7b, 0x31, 0x67, 0x20,
+	0xc6, 0xdb, 0x5a, 0xb8, 0xc3, 0xc1, 0xfb, 0x9f, 0x2f, 0x86, 0x4a, 0x06, 0xe9, 0x68, 0xc5, 0xc9,
+	0xab, 0x8a, 0xdb, 0x2f, 0x51, 0x36, 0xba, 0xfd, 0xb7, 0xf2, 0xf2, 0x1d, 0x88, 0x49, 0xfa, 0x2f,
+	0x2e, 0xff, 0x0f, 0xc2, 0x67, 0x04, 0x6e, 0xa3, 0xad, 0x12, 0xfa, 0x42, 0xdd, 0xfd, 0xc1, 0x22,
+	0x42, 0xd2, 0x00, 0x4b, 0x43, 0x17, 0xd4, 0xd7, 0x68, 0xed, 0x58, 0x04, 0x93, 0xe1, 0xdc, 0xb7,
+	0xca, 0xbe, 0x02, 0x56, 0x75, 0x98, 0xf4, 0xc3, 0x79, 0x77, 0x64, 0xf1, 0x53, 0xdf, 0xa0, 0x7a,
+	0x8b, 0x11, 0x17, 0x38, 0x9f, 0x8e, 0xfc, 0xde, 0x6f, 0x4f, 0x9c, 0x42, 0xeb, 0x07, 0xf3, 0xa0,
+	0x47, 0xff, 0xbe, 0x2e, 0x5a, 0x2f, 0x0b, 0x7e, 0x05, 0x75, 0x79, 0xe2, 0xf4, 0x83, 0x79, 0xd0,
+	0x23, 0x6a, 0x8a, 0x6e, 0xcf, 0x64, 0xf3, 0x6e, 0xe5, 0x49, 0xd3, 0x50, 0xbd, 0x79, 0x6d, 0xe8,
+	0xa4, 0xd9, 0xb2, 0x30, 0x55, 0x9b, 0x2d, 0x41, 0xeb, 0x07, 0xf3, 0xa0, 0x27, 0xcd, 0xce, 0x64,
+	0xa1, 0xda, 0xec, 0x34, 0x54, 0x6f, 0x5e, 0x1b, 0x5a, 0x30, 0xea, 0x8b, 0xef, 0x06, 0x97, 0xbb,
+	0xca, 0xd1, 0xd3, 0xaf, 0x3d, 0x43, 0xb9, 0xea, 0x19, 0xca, 0x8f, 0x9e, 0xa1, 0x7c, 0xec, 0x1b,
+	0x0b, 0x57, 0x7d, 0x63, 0xe1, 0x7b, 0xdf, 0x58, 0x78, 0x69, 0xfb, 0xa1, 0x08, 0x3a, 0x6d, 0xcb,
+	0x25, 0xb1, 0xdd, 0x06, 0x86, 0xdd, 0x00, 0x87, 0x89, 0x5d, 0xcc, 0xc6, 0x7c, 0xdc, 0x5d, 0xc8,
+	0x21, 0x29, 0xba, 0x14, 0x78, 0x7b, 0x49, 0x4e, 0xb5, 0x07, 0x3f, 0x07, 0x00, 0x90, 0x97, 0x16,
+	0xe9, 0x40, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -243,6 +688,14 @@ type MsgServiceClient interface {
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(ctx context.Context, in *WrappedPayloadEnvelope, opts ...grpc.CallOption) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdatePrecompileGas defines a governance operation to set the base gas of a precompile method.
+	UpdatePrecompileGas(ctx context.Context, in *MsgUpdatePrecompileGas, opts ...grpc.CallOption) (*MsgUpdatePrecompileGasResponse, error)
+	// CreatePrecompile defines a governance operation to instantiate a dynamic precompile.
+	CreatePrecompile(ctx context.Context, in *MsgCreatePrecompile, opts ...grpc.CallOption) (*MsgCreatePrecompileResponse, error)
+	// SetPrecompilePaused defines a governance operation to pause or resume a dynamic precompile.
+	SetPrecompilePaused(ctx context.Context, in *MsgSetPrecompilePaused, opts ...grpc.CallOption) (*MsgSetPrecompilePausedResponse, error)
+	// RemovePrecompile defines a governance operation to remove a dynamic precompile.
+	RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error)
 }
 
 type msgServiceClient struct {
@@ -271,12 +724,56 @@ func (c *msgServiceClient) ProcessPayloadEnvelope(ctx context.Context, in *Wrapp
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdatePrecompileGas(ctx context.Context, in *MsgUpdatePrecompileGas, opts ...grpc.CallOption) (*MsgUpdatePrecompileGasResponse, error) {
+	out := new(MsgUpdatePrecompileGasResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdatePrecompileGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CreatePrecompile(ctx context.Context, in *MsgCreatePrecompile, opts ...grpc.CallOption) (*MsgCreatePrecompileResponse, error) {
+	out := new(MsgCreatePrecompileResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/CreatePrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetPrecompilePaused(ctx context.Context, in *MsgSetPrecompilePaused, opts ...grpc.CallOption) (*MsgSetPrecompilePausedResponse, error) {
+	out := new(MsgSetPrecompilePausedResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/SetPrecompilePaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error) {
+	out := new(MsgRemovePrecompileResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/RemovePrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdatePrecompileGas defines a governance operation to set the base gas of a precompile method.
+	UpdatePrecompileGas(context.Context, *MsgUpdatePrecompileGas) (*MsgUpdatePrecompileGasResponse, error)
+	// CreatePrecompile defines a governance operation to instantiate a dynamic precompile.
+	CreatePrecompile(context.Context, *MsgCreatePrecompile) (*MsgCreatePrecompileResponse, error)
+	// SetPrecompilePaused defines a governance operation to pause or resume a dynamic precompile.
+	SetPrecompilePaused(context.Context, *MsgSetPrecompilePaused) (*MsgSetPrecompilePausedResponse, error)
+	// RemovePrecompile defines a governance operation to remove a dynamic precompile.
+	RemovePrecompile(context.Context, *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -289,6 +786,18 @@ func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *W
 func (*UnimplementedMsgServiceServer) ProcessPayloadEnvelope(ctx context.Context, req *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayloadEnvelope not implemented")
 }
+func (*UnimplementedMsgServiceServer) UpdatePrecompileGas(ctx context.Context, req *MsgUpdatePrecompileGas) (*MsgUpdatePrecompileGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrecompileGas not implemented")
+}
+func (*UnimplementedMsgServiceServer) CreatePrecompile(ctx context.Context, req *MsgCreatePrecompile) (*MsgCreatePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrecompile not implemented")
+}
+func (*UnimplementedMsgServiceServer) SetPrecompilePaused(ctx context.Context, req *MsgSetPrecompilePaused) (*MsgSetPrecompilePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrecompilePaused not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemovePrecompile(ctx context.Context, req *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePrecompile not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -330,18 +839,106 @@ func _MsgService_ProcessPayloadEnvelope_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _MsgService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "polaris.evm.v1alpha1.MsgService",
-	HandlerType: (*MsgServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthTransaction",
-			Handler:    _MsgService_EthTransaction_Handler,
-		},
+func _MsgService_UpdatePrecompileGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrecompileGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdatePrecompileGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdatePrecompileGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdatePrecompileGas(ctx, req.(*MsgUpdatePrecompileGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreatePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreatePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/CreatePrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreatePrecompile(ctx, req.(*MsgCreatePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetPrecompilePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPrecompilePaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetPrecompilePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/SetPrecompilePaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetPrecompilePaused(ctx, req.(*MsgSetPrecompilePaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemovePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemovePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/RemovePrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemovePrecompile(ctx, req.(*MsgRemovePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MsgService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "polaris.evm.v1alpha1.MsgService",
+	HandlerType: (*MsgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthTransaction",
+			Handler:    _MsgService_EthTransaction_Handler,
+		},
 		{
 			MethodName: "ProcessPayloadEnvelope",
 			Handler:    _MsgService_ProcessPayloadEnvelope_Handler,
 		},
+		{
+			MethodName: "UpdatePrecompileGas",
+			Handler:    _MsgService_UpdatePrecompileGas_Handler,
+		},
+		{
+			MethodName: "CreatePrecompile",
+			Handler:    _MsgService_CreatePrecompile_Handler,
+		},
+		{
+			MethodName: "SetPrecompilePaused",
+			Handler:    _MsgService_SetPrecompilePaused_Handler,
+		},
+		{
+			MethodName: "RemovePrecompile",
+			Handler:    _MsgService_RemovePrecompile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -453,68 +1050,1095 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePrecompileGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WrappedEthereumTransaction) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdatePrecompileGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x20
 	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Precompile) > 0 {
+		i -= len(m.Precompile)
+		copy(dAtA[i:], m.Precompile)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Precompile)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrecompileGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WrappedPayloadEnvelope) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WrappedPayloadEnvelopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPrecompilePaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrecompilePaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrecompilePaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Precompile) > 0 {
+		i -= len(m.Precompile)
+		copy(dAtA[i:], m.Precompile)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Precompile)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPrecompilePausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrecompilePausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrecompilePausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Precompile) > 0 {
+		i -= len(m.Precompile)
+		copy(dAtA[i:], m.Precompile)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Precompile)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WrappedEthereumTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedPayloadEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedPayloadEnvelopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WrappedEthereumTransactionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePrecompileGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Precompile)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovTx(uint64(m.BaseGas))
+	}
+	return n
+}
+
+func (m *MsgUpdatePrecompileGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPrecompilePaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Precompile)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPrecompilePausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Precompile)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedPayloadEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedPayloadEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedPayloadEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedPayloadEnvelopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedPayloadEnvelopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedPayloadEnvelopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePrecompileGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePrecompileGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePrecompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePrecompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *WrappedEthereumTransactionResult) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePrecompileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -537,17 +2161,17 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePrecompileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -557,25 +2181,23 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -598,7 +2220,7 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedPayloadEnvelope) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPrecompilePaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,17 +2243,17 @@ func (m *WrappedPayloadEnvelope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedPayloadEnvelope: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPrecompilePaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedPayloadEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPrecompilePaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -641,26 +2263,76 @@ func (m *WrappedPayloadEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Precompile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -682,7 +2354,7 @@ func (m *WrappedPayloadEnvelope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedPayloadEnvelopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPrecompilePausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,10 +2377,10 @@ func (m *WrappedPayloadEnvelopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedPayloadEnvelopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPrecompilePausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedPayloadEnvelopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPrecompilePausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -732,7 +2404,7 @@ func (m *WrappedPayloadEnvelopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePrecompile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -755,10 +2427,124 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePrecompile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26,6 +26,7 @@
 package bank_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -33,6 +34,7 @@ import (
 	localnet "github.com/berachain/polaris/e2e/localnet/network"
 	utils "github.com/berachain/polaris/e2e/precompile"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 
 	. "github.com/berachain/polaris/e2e/localnet/utils"
@@ -139,4 +141,33 @@ var _ = Describe("Bank", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(totalSupply).To(HaveLen(numberOfDenoms))
 	})
+
+	It("should include the base gas of the methods in eth_estimateGas", func() {
+		const (
+			intrinsicGas = 21_000
+			queryBaseGas = 2_000  // precompile.QueryBaseGas
+			txBaseGas    = 25_000 // precompile.TxBaseGas
+		)
+		bankAddress := common.HexToAddress("0x4381dC2aB14285160c808659aEe005D51255adD7")
+		bankABI, err := bindings.BankModuleMetaData.GetAbi()
+		Expect(err).ToNot(HaveOccurred())
+
+		input, err := bankABI.Pack("getBalance", tf.Address("charlie"), denom)
+		Expect(err).ToNot(HaveOccurred())
+		gas, err := tf.EthClient().EstimateGas(context.Background(), ethereum.CallMsg{
+			From: tf.Address("alice"), To: &bankAddress, Data: input,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gas).To(BeNumerically(">=", intrinsicGas+queryBaseGas))
+
+		input, err = bankABI.Pack("send", tf.Address("charlie"), []bindings.CosmosCoin{
+			{Denom: denom, Amount: big.NewInt(1)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		gas, err = tf.EthClient().EstimateGas(context.Background(), ethereum.CallMsg{
+			From: tf.Address("alice"), To: &bankAddress, Data: input,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gas).To(BeNumerically(">=", intrinsicGas+txBaseGas))
+	})
 })
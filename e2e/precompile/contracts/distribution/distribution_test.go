@@ -117,7 +117,6 @@ var _ = Describe("Distribution Precompile", func() {
 		val := validators[0]
 		delegateAmt := big.NewInt(123450000000)
 		txr := tf.GenerateTransactOpts("alice")
-		txr.Value = delegateAmt
 		tx, err := stakingPrecompile.Delegate(txr, val.OperatorAddr, delegateAmt)
 		Expect(err).ToNot(HaveOccurred())
 		ExpectSuccessReceipt(tf.EthClient(), tx)
@@ -91,7 +91,6 @@ var _ = Describe("Staking", func() {
 		Expect(delegated.Cmp(big.NewInt(0))).To(Equal(0))
 
 		txr := tf.GenerateTransactOpts("alice")
-		txr.Value = delegateAmt
 		tx, err := stakingPrecompile.Delegate(txr, validator, delegateAmt)
 		Expect(err).ToNot(HaveOccurred())
 		ExpectSuccessReceipt(tf.EthClient(), tx)
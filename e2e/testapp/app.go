@@ -41,6 +41,8 @@ import (
 	"github.com/berachain/polaris/cosmos/runtime/ante"
 	"github.com/berachain/polaris/cosmos/runtime/miner"
 	evmkeeper "github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmstate "github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -55,7 +57,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -142,6 +146,8 @@ func NewPolarisApp(
 				PolarisConfigFn(evmconfig.MustReadConfigFromAppOpts(appOpts)),
 				PrecompilesToInject(app),
 				QueryContextFn(app),
+				StoreQueryFn(app),
+				BankBalances(),
 				//
 				// AUTH
 				//
@@ -195,6 +201,14 @@ func NewPolarisApp(
 
 	// Build the app using the app builder.
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+
+	// Track the accounts of the EVM that are written by the other modules, for the state root.
+	app.SetCMS(evmstate.TrackAccounts(
+		app.CommitMultiStore(),
+		app.UnsafeFindStoreKey(evmtypes.StoreKey),
+		app.UnsafeFindStoreKey(authtypes.StoreKey),
+		app.UnsafeFindStoreKey(banktypes.StoreKey),
+	))
 	app.Polaris = polarruntime.New(app,
 		polarisConfig, app.Logger(), app.EVMKeeper.Host, nil,
 	)
@@ -218,7 +232,7 @@ func NewPolarisApp(
 
 	// Setup Polaris Runtime.
 	if err = app.Polaris.Build(
-		app, cosmHandler, app.EVMKeeper, miner.DefaultAllowedMsgs,
+		app, cosmHandler, app.EVMKeeper, app.StakingKeeper, miner.DefaultAllowedMsgs,
 	); err != nil {
 		panic(err)
 	}
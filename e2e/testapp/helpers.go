@@ -26,6 +26,7 @@ import (
 	distrprecompile "github.com/berachain/polaris/cosmos/precompile/distribution"
 	govprecompile "github.com/berachain/polaris/cosmos/precompile/governance"
 	stakingprecompile "github.com/berachain/polaris/cosmos/precompile/staking"
+	evmstate "github.com/berachain/polaris/cosmos/x/evm/plugins/state"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,6 +64,13 @@ func PrecompilesToInject(
 			stakingprecompile.NewPrecompileContract(app.AccountKeeper, app.StakingKeeper),
 		}...)
 
+		// Add the bank denom precompiles, which are instantiated by governance.
+		pcs.AddDynamicKind(bankprecompile.DenomKind, bankprecompile.NewDenomConstructor(
+			app.AccountKeeper,
+			bankkeeper.NewMsgServerImpl(app.BankKeeper),
+			app.BankKeeper,
+		))
+
 		// Add the custom precompiles to the injector.
 		for _, pc := range customPcs {
 			pcs.AddPrecompile(pc)
@@ -79,6 +87,20 @@ func QueryContextFn(app *SimApp) func() func(height int64, prove bool) (sdk.Cont
 	}
 }
 
+// StoreQueryFn returns a function that provides the ABCI store queries of the app, which are
+// used to serve state proofs.
+func StoreQueryFn(app *SimApp) func() evmstate.StoreQueryFn {
+	return func() evmstate.StoreQueryFn {
+		return app.BaseApp.Query
+	}
+}
+
+// BankBalances returns the bank denom that backs the native value of the EVM, so that the EVM
+// balances are the bank balances of the bond denom.
+func BankBalances() *evmstate.BankBalancesConfig {
+	return &evmstate.BankBalancesConfig{Denom: "abera", Decimals: 18}
+}
+
 // PolarisConfigFn returns a function that provides the initialization of the standard
 // set of precompiles.
 func PolarisConfigFn(cfg *evmconfig.Config) func() *evmconfig.Config {
@@ -21,7 +21,9 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -33,6 +35,7 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 
 	polarconfig "github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/x/evm"
 	testapp "github.com/berachain/polaris/e2e/testapp"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -52,6 +55,7 @@ import (
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -115,6 +119,11 @@ func genesisCommand(
 	txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command,
 ) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, testapp.DefaultNodeHome)
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			withEVMGenesisValidation(subCmd)
+		}
+	}
 
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
@@ -122,6 +131,33 @@ func genesisCommand(
 	return cmd
 }
 
+// withEVMGenesisValidation extends the genesis validate command to also validate the evm genesis
+// against the auth genesis, as the module basics only validate the genesis of every module on its
+// own.
+func withEVMGenesisValidation(validateCmd *cobra.Command) {
+	runE := validateCmd.RunE
+	validateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		genesis := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+		if len(args) > 0 {
+			genesis = args[0]
+		}
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+		if err != nil {
+			return err
+		}
+		var appState map[string]json.RawMessage
+		if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+			return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+		}
+		if err = evm.ValidateGenesisWithAuth(
+			client.GetClientContextFromCmd(cmd).Codec, appState,
+		); err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+		}
+		return runE(cmd, args)
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
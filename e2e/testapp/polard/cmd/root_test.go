@@ -21,8 +21,11 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	testapp "github.com/berachain/polaris/e2e/testapp"
@@ -30,7 +33,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -81,3 +89,71 @@ var _ = Describe("Home flag registration", func() {
 		Expect(result).To(Equal(homeDir))
 	})
 })
+
+var _ = Describe("Genesis validate command", func() {
+	var (
+		home    string
+		account = common.HexToAddress("0x1234")
+	)
+
+	// writeGenesis sets the given sequence of the auth account and nonce of the evm alloc of the
+	// same address in the initialized genesis file.
+	writeGenesis := func(sequence, nonce uint64) {
+		genFile := filepath.Join(home, "config", "genesis.json")
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+		Expect(err).ToNot(HaveOccurred())
+		var appState map[string]json.RawMessage
+		Expect(json.Unmarshal(appGenesis.AppState, &appState)).To(Succeed())
+
+		var authState map[string]any
+		Expect(json.Unmarshal(appState["auth"], &authState)).To(Succeed())
+		authState["accounts"] = []any{map[string]any{
+			"@type":          "/cosmos.auth.v1beta1.BaseAccount",
+			"address":        sdk.AccAddress(account.Bytes()).String(),
+			"pub_key":        nil,
+			"account_number": "0",
+			"sequence":       strconv.FormatUint(sequence, 10),
+		}}
+		appState["auth"], err = json.Marshal(authState)
+		Expect(err).ToNot(HaveOccurred())
+
+		var evmState map[string]any
+		Expect(json.Unmarshal(appState["evm"], &evmState)).To(Succeed())
+		evmState["alloc"] = map[string]any{
+			account.Hex(): map[string]any{"balance": "0x0", "nonce": hexutil.EncodeUint64(nonce)},
+		}
+		appState["evm"], err = json.Marshal(evmState)
+		Expect(err).ToNot(HaveOccurred())
+
+		appGenesis.AppState, err = json.Marshal(appState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appGenesis.SaveAs(genFile)).To(Succeed())
+	}
+
+	execute := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		return svrcmd.Execute(rootCmd, "", home)
+	}
+
+	BeforeEach(func() {
+		stdout := os.Stdout
+		DeferCleanup(func() { os.Stdout = stdout })
+		os.Stdout = os.NewFile(0, os.DevNull)
+
+		home = GinkgoT().TempDir()
+		Expect(execute("init", "simapp-test")).To(Succeed())
+	})
+
+	It("should accept evm allocs whose nonces match the auth sequences", func() {
+		writeGenesis(1, 1)
+		Expect(execute("genesis", "validate")).To(Succeed())
+	})
+
+	It("should reject evm allocs whose nonces do not match the auth sequences", func() {
+		writeGenesis(1, 0)
+		err := execute("genesis", "validate")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not match the auth genesis sequence"))
+	})
+})
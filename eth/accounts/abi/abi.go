@@ -34,14 +34,21 @@ type (
 	Argument           = abi.Argument
 	ArgumentMarshaling = abi.ArgumentMarshaling
 	Arguments          = abi.Arguments
+	Error              = abi.Error
 	Event              = abi.Event
 	Method             = abi.Method
 )
 
+const (
+	Fallback = abi.Fallback
+	Receive  = abi.Receive
+)
+
 var (
-	MakeTopics = abi.MakeTopics
-	NewEvent   = abi.NewEvent
-	NewType    = abi.NewType
+	MakeTopics   = abi.MakeTopics
+	NewEvent     = abi.NewEvent
+	NewType      = abi.NewType
+	UnpackRevert = abi.UnpackRevert
 )
 
 // ToMixedCase converts a under_score formatted string to mixedCase format (camelCase with the
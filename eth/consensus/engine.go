@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/eth/core/state"
+	errorslib "github.com/berachain/polaris/lib/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+type Engine consensus.Engine
+
+// polarisEngine is the Polaris implementation of the consensus.Engine interface.
+var _ Engine = (*polarisEngine)(nil)
+
+// polarisEngine is the consensus engine of a Polaris chain. Blocks are proposed and finalized by
+// the consensus of the host chain, so the engine follows the post-merge rules: it does not seal
+// blocks nor issue block rewards, but fully verifies the headers of proposed blocks against their
+// parent headers.
+type polarisEngine struct{}
+
+// NewEngine returns the consensus engine of a Polaris chain.
+func NewEngine() Engine {
+	return &polarisEngine{}
+}
+
+// Author returns the coinbase of the header, which is the block proposer.
+func (e *polarisEngine) Author(header *ethtypes.Header) (common.Address, error) {
+	return header.Coinbase, nil
+}
+
+// VerifyHeader checks whether the header conforms to the consensus rules, given its parent in
+// the chain.
+func (e *polarisEngine) VerifyHeader(
+	chain consensus.ChainHeaderReader, header *ethtypes.Header,
+) error {
+	if header.Number.Sign() <= 0 {
+		return ErrInvalidNumber
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil || parent.Hash() != header.ParentHash {
+		return errorslib.Wrapf(ErrUnknownParent, "parent %s", header.ParentHash.Hex())
+	}
+	return e.verifyHeader(chain, header, parent)
+}
+
+// VerifyHeaders verifies a batch of headers concurrently, in order. The returned quit channel
+// aborts the verification and the results channel receives one error per header.
+func (e *polarisEngine) VerifyHeaders(
+	chain consensus.ChainHeaderReader, headers []*ethtypes.Header,
+) (chan<- struct{}, <-chan error) {
+	var (
+		abort   = make(chan struct{})
+		results = make(chan error, len(headers))
+	)
+	go func() {
+		for i, header := range headers {
+			var err error
+			if i == 0 || headers[i-1].Hash() != header.ParentHash {
+				err = e.VerifyHeader(chain, header)
+			} else {
+				err = e.verifyHeader(chain, header, headers[i-1])
+			}
+			select {
+			case <-abort:
+				return
+			case results <- err:
+			}
+		}
+	}()
+	return abort, results
+}
+
+// verifyHeader checks whether the header conforms to the consensus rules, given its parent.
+func (e *polarisEngine) verifyHeader(
+	chain consensus.ChainHeaderReader, header, parent *ethtypes.Header,
+) error {
+	// Verify the block number and the timestamp against the parent.
+	if new(big.Int).Sub(header.Number, parent.Number).Cmp(common.Big1) != 0 {
+		return errorslib.Wrapf(
+			ErrInvalidNumber, "have %v, parent %v", header.Number, parent.Number,
+		)
+	}
+	if header.Time <= parent.Time {
+		return errorslib.Wrapf(
+			ErrInvalidTimestamp, "have %d, parent %d", header.Time, parent.Time,
+		)
+	}
+
+	// Verify the size of the extra-data.
+	if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
+		return errorslib.Wrapf(
+			ErrExtraDataTooLong, "have %d, max %d", len(header.Extra), params.MaximumExtraDataSize,
+		)
+	}
+
+	// Verify the post-merge seal fields.
+	if header.Difficulty == nil || header.Difficulty.Sign() != 0 {
+		return errorslib.Wrapf(ErrInvalidDifficulty, "have %v", header.Difficulty)
+	}
+	if header.Nonce != (ethtypes.BlockNonce{}) {
+		return errorslib.Wrapf(ErrInvalidNonce, "have %d", header.Nonce.Uint64())
+	}
+	if header.UncleHash != ethtypes.EmptyUncleHash {
+		return errorslib.Wrapf(ErrInvalidUncles, "uncle hash %s", header.UncleHash.Hex())
+	}
+
+	// Verify the gas limit and gas used.
+	if header.GasLimit > params.MaxGasLimit {
+		return errorslib.Wrapf(
+			ErrInvalidGasLimit, "have %d, max %d", header.GasLimit, params.MaxGasLimit,
+		)
+	}
+	if header.GasUsed > header.GasLimit {
+		return errorslib.Wrapf(
+			ErrInvalidGasUsed, "have %d, gas limit %d", header.GasUsed, header.GasLimit,
+		)
+	}
+
+	// Verify the gas limit bounds and the base fee derived from the parent (EIP-1559).
+	config := chain.Config()
+	if config.IsLondon(header.Number) {
+		if err := eip1559.VerifyEIP1559Header(config, parent, header); err != nil {
+			return err
+		}
+	} else if err := misc.VerifyGaslimit(parent.GasLimit, header.GasLimit); err != nil {
+		return err
+	}
+
+	// Verify the fields introduced by Shanghai and Cancun.
+	return verifyForkFields(config, header, parent)
+}
+
+// verifyForkFields checks the existence of the fields introduced by the Shanghai and Cancun forks
+// and verifies the blob gas fields (EIP-4844) against the parent.
+func verifyForkFields(config *params.ChainConfig, header, parent *ethtypes.Header) error {
+	if shanghai := config.IsShanghai(header.Number, header.Time); shanghai !=
+		(header.WithdrawalsHash != nil) {
+		return errorslib.Wrapf(
+			ErrInvalidForkFields, "shanghai %t, withdrawals hash set %t",
+			shanghai, header.WithdrawalsHash != nil,
+		)
+	}
+
+	if !config.IsCancun(header.Number, header.Time) {
+		if header.ExcessBlobGas != nil || header.BlobGasUsed != nil ||
+			header.ParentBeaconRoot != nil {
+			return errorslib.Wrap(ErrInvalidForkFields, "cancun fields set before cancun")
+		}
+		return nil
+	}
+	if header.ParentBeaconRoot == nil {
+		return errorslib.Wrap(ErrInvalidForkFields, "missing parent beacon root")
+	}
+	return eip4844.VerifyEIP4844Header(parent, header)
+}
+
+// VerifyUncles verifies that the block does not include uncles.
+func (e *polarisEngine) VerifyUncles(_ consensus.ChainReader, block *ethtypes.Block) error {
+	if len(block.Uncles()) > 0 {
+		return ErrInvalidUncles
+	}
+	return nil
+}
+
+// Prepare initializes the difficulty of the header to the post-merge value.
+func (e *polarisEngine) Prepare(_ consensus.ChainHeaderReader, header *ethtypes.Header) error {
+	header.Difficulty = new(big.Int)
+	return nil
+}
+
+// Finalize credits the withdrawals of the block. There are no block rewards, which are issued by
+// the host chain instead.
+func (e *polarisEngine) Finalize(
+	_ consensus.ChainHeaderReader, _ *ethtypes.Header, state state.StateDB,
+	_ []*ethtypes.Transaction, _ []*ethtypes.Header, withdrawals []*ethtypes.Withdrawal,
+) {
+	for _, w := range withdrawals {
+		// Convert the amount from gwei to wei.
+		amount := new(big.Int).SetUint64(w.Amount)
+		state.AddBalance(w.Address, amount.Mul(amount, big.NewInt(params.GWei)))
+	}
+}
+
+// FinalizeAndAssemble finalizes the block, sets its state root and assembles it.
+func (e *polarisEngine) FinalizeAndAssemble(
+	chain consensus.ChainHeaderReader, header *ethtypes.Header, state state.StateDB,
+	txs []*ethtypes.Transaction, uncles []*ethtypes.Header, receipts []*ethtypes.Receipt,
+	withdrawals []*ethtypes.Withdrawal,
+) (*ethtypes.Block, error) {
+	if chain.Config().IsShanghai(header.Number, header.Time) {
+		// All blocks after Shanghai must include a withdrawals root.
+		if withdrawals == nil {
+			withdrawals = make([]*ethtypes.Withdrawal, 0)
+		}
+	} else if len(withdrawals) > 0 {
+		return nil, errorslib.Wrap(ErrInvalidForkFields, "withdrawals set before shanghai")
+	}
+
+	e.Finalize(chain, header, state, txs, uncles, withdrawals)
+	header.Root = state.IntermediateRoot(true)
+	return ethtypes.NewBlockWithWithdrawals(
+		header, txs, uncles, receipts, withdrawals, trie.NewStackTrie(nil),
+	), nil
+}
+
+// Seal does not seal the block, since blocks are sealed by the consensus of the host chain. No
+// result is pushed to the results channel.
+func (e *polarisEngine) Seal(
+	consensus.ChainHeaderReader, *ethtypes.Block, chan<- *ethtypes.Block, <-chan struct{},
+) error {
+	return nil
+}
+
+// SealHash returns the hash of the header.
+func (e *polarisEngine) SealHash(header *ethtypes.Header) common.Hash {
+	return header.Hash()
+}
+
+// CalcDifficulty returns the post-merge difficulty, which is zero.
+func (e *polarisEngine) CalcDifficulty(
+	consensus.ChainHeaderReader, uint64, *ethtypes.Header,
+) *big.Int {
+	return new(big.Int)
+}
+
+// APIs returns no APIs.
+func (e *polarisEngine) APIs(consensus.ChainHeaderReader) []rpc.API {
+	return nil
+}
+
+// Close is a no-op.
+func (e *polarisEngine) Close() error {
+	return nil
+}
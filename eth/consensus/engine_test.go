@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus_test
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/eth/consensus"
+	pparams "github.com/berachain/polaris/eth/params"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Engine", func() {
+	var (
+		engine consensus.Engine
+		chain  *mockChain
+		parent *ethtypes.Header
+		header *ethtypes.Header
+	)
+
+	BeforeEach(func() {
+		engine = consensus.NewEngine()
+		chain = &mockChain{config: pparams.DefaultChainConfig, headers: map[uint64]*ethtypes.Header{}}
+		excessBlobGas, blobGasUsed := uint64(0), uint64(0)
+		parent = &ethtypes.Header{
+			Number:           big.NewInt(10),
+			Time:             100,
+			GasLimit:         30_000_000,
+			GasUsed:          20_000_000,
+			BaseFee:          big.NewInt(params.InitialBaseFee),
+			Difficulty:       new(big.Int),
+			UncleHash:        ethtypes.EmptyUncleHash,
+			WithdrawalsHash:  &ethtypes.EmptyWithdrawalsHash,
+			ExcessBlobGas:    &excessBlobGas,
+			BlobGasUsed:      &blobGasUsed,
+			ParentBeaconRoot: &common.Hash{},
+		}
+		chain.headers[10] = parent
+
+		excessBlobGas = eip4844.CalcExcessBlobGas(*parent.ExcessBlobGas, *parent.BlobGasUsed)
+		header = &ethtypes.Header{
+			ParentHash:       parent.Hash(),
+			Number:           big.NewInt(11),
+			Time:             101,
+			GasLimit:         parent.GasLimit,
+			BaseFee:          eip1559.CalcBaseFee(chain.config, parent),
+			Difficulty:       new(big.Int),
+			UncleHash:        ethtypes.EmptyUncleHash,
+			Extra:            make([]byte, params.MaximumExtraDataSize),
+			WithdrawalsHash:  &ethtypes.EmptyWithdrawalsHash,
+			ExcessBlobGas:    &excessBlobGas,
+			BlobGasUsed:      new(uint64),
+			ParentBeaconRoot: &common.Hash{0x01},
+		}
+	})
+
+	It("should accept a valid header", func() {
+		Expect(engine.VerifyHeader(chain, header)).To(Succeed())
+
+		author, err := engine.Author(header)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(author).To(Equal(header.Coinbase))
+	})
+
+	It("should reject a header with an unknown parent", func() {
+		header.ParentHash = common.Hash{0x02}
+		Expect(engine.VerifyHeader(chain, header)).To(MatchError(consensus.ErrUnknownParent))
+	})
+
+	It("should reject an arbitrary base fee", func() {
+		header.BaseFee = new(big.Int).Add(header.BaseFee, big.NewInt(1))
+		Expect(engine.VerifyHeader(chain, header)).To(HaveOccurred())
+	})
+
+	It("should reject a gas limit out of bounds", func() {
+		header.GasLimit = parent.GasLimit + parent.GasLimit/params.GasLimitBoundDivisor
+		Expect(engine.VerifyHeader(chain, header)).To(HaveOccurred())
+
+		header.GasLimit = parent.GasLimit
+		header.GasUsed = header.GasLimit + 1
+		Expect(engine.VerifyHeader(chain, header)).To(MatchError(consensus.ErrInvalidGasUsed))
+	})
+
+	It("should reject a timestamp not after the parent's", func() {
+		header.Time = parent.Time
+		Expect(engine.VerifyHeader(chain, header)).To(MatchError(consensus.ErrInvalidTimestamp))
+	})
+
+	It("should reject too long extra-data", func() {
+		header.Extra = make([]byte, params.MaximumExtraDataSize+1)
+		Expect(engine.VerifyHeader(chain, header)).To(MatchError(consensus.ErrExtraDataTooLong))
+	})
+
+	It("should reject missing fork fields", func() {
+		header.ParentBeaconRoot = nil
+		Expect(engine.VerifyHeader(chain, header)).To(MatchError(consensus.ErrInvalidForkFields))
+	})
+
+	It("should verify headers in batches", func() {
+		child := ethtypes.CopyHeader(header)
+		child.ParentHash = header.Hash()
+		child.Number = big.NewInt(12)
+		child.Time = header.Time
+
+		_, results := engine.VerifyHeaders(chain, []*ethtypes.Header{header, child})
+		Expect(<-results).To(Succeed())
+		Expect(<-results).To(MatchError(consensus.ErrInvalidTimestamp))
+	})
+})
+
+// mockChain is a consensus.ChainHeaderReader serving headers by number.
+type mockChain struct {
+	config  *params.ChainConfig
+	headers map[uint64]*ethtypes.Header
+}
+
+func (c *mockChain) Config() *params.ChainConfig { return c.config }
+
+func (c *mockChain) CurrentHeader() *ethtypes.Header { return nil }
+
+func (c *mockChain) GetHeader(_ common.Hash, number uint64) *ethtypes.Header {
+	return c.headers[number]
+}
+
+func (c *mockChain) GetHeaderByNumber(number uint64) *ethtypes.Header {
+	return c.headers[number]
+}
+
+func (c *mockChain) GetHeaderByHash(common.Hash) *ethtypes.Header { return nil }
+
+func (c *mockChain) GetTd(common.Hash, uint64) *big.Int { return nil }
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus
+
+import "errors"
+
+var (
+	// ErrUnknownParent is returned when the parent of a header is not known.
+	ErrUnknownParent = errors.New("unknown parent header")
+	// ErrInvalidNumber is returned if the number of a header is not its parent's number + 1.
+	ErrInvalidNumber = errors.New("invalid block number")
+	// ErrInvalidTimestamp is returned if the timestamp of a header is not after its parent's.
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	// ErrExtraDataTooLong is returned if the extra-data of a header exceeds its maximum size.
+	ErrExtraDataTooLong = errors.New("extra-data too long")
+	// ErrInvalidGasLimit is returned if the gas limit of a header exceeds its maximum.
+	ErrInvalidGasLimit = errors.New("invalid gas limit")
+	// ErrInvalidGasUsed is returned if the gas used by a header exceeds its gas limit.
+	ErrInvalidGasUsed = errors.New("invalid gas used")
+	// ErrInvalidDifficulty is returned if the difficulty of a header is not zero.
+	ErrInvalidDifficulty = errors.New("invalid difficulty")
+	// ErrInvalidNonce is returned if the nonce of a header is not zero.
+	ErrInvalidNonce = errors.New("invalid nonce")
+	// ErrInvalidUncles is returned if a block includes uncles.
+	ErrInvalidUncles = errors.New("uncles not allowed")
+	// ErrInvalidForkFields is returned if the fork specific fields of a header do not match the
+	// forks active at the header.
+	ErrInvalidForkFields = errors.New("invalid fork specific fields")
+)
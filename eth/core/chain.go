@@ -32,16 +32,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
-// By default we are storing up to 512 items in each cache.
-const defaultCacheSize = 512
+const (
+	// By default we are storing up to 512 items in each cache.
+	defaultCacheSize = 512
+	// badBlockLimit is the number of bad blocks whose rejection reasons are kept, it matches the
+	// number of bad blocks that are kept by go-ethereum.
+	badBlockLimit = 10
+)
 
 // Compile-time check to ensure that `blockchain` implements the `Blockchain` api.
 var _ Blockchain = (*blockchain)(nil)
@@ -49,6 +56,10 @@ var _ Blockchain = (*blockchain)(nil)
 // Blockchain interface defines the methods that a blockchain must have.
 type Blockchain interface {
 	ChainReader
+	ChainBloomIndex
+	ChainWithdrawalsReader
+	ChainBadBlocks
+	ChainPreimages
 	ChainWriter
 	ChainSubscriber
 	ChainResources
@@ -64,6 +75,7 @@ type blockchain struct {
 	bp  BlockPlugin
 	hp  HistoricalPlugin
 	pp  PrecompilePlugin
+	wp  WithdrawalsPlugin
 	spf StatePluginFactory
 
 	engine    consensus.Engine
@@ -80,6 +92,8 @@ type blockchain struct {
 	currentBlock atomic.Pointer[ethtypes.Block]
 	// finalizedBlock is the finalized/latest block.
 	finalizedBlock atomic.Pointer[ethtypes.Block]
+	// miningTime is the timestamp of the block that the miner builds on top of the current block.
+	miningTime atomic.Uint64
 
 	// receiptsCache is a cache of the receipts for the last `defaultCacheSizeBytes` bytes of
 	// blocks. blockHash -> receipts
@@ -94,6 +108,16 @@ type blockchain struct {
 	// blocks. txHash -> txLookupEntry
 	txLookupCache *lru.Cache[common.Hash, *types.TxLookupEntry]
 
+	// badBlocks is an in-memory database of the most recent blocks that failed to be inserted.
+	badBlocks ethdb.Database
+	// badBlockReasons is a cache of why the bad blocks were rejected. blockHash -> reason
+	badBlockReasons *lru.Cache[common.Hash, string]
+	// preimages is the non-consensus database of the recorded SHA3 preimages, nil if preimage
+	// recording is disabled.
+	preimages ethdb.KeyValueStore
+	// blooms is the non-consensus database of the bloom-bits index, nil if the index is disabled.
+	blooms ethdb.KeyValueStore
+
 	// subscription event feeds
 	scope         event.SubscriptionScope
 	chainFeed     event.Feed
@@ -113,20 +137,23 @@ func NewChain(
 	host PolarisHostChain, config *params.ChainConfig, engine consensus.Engine,
 ) *blockchain { //nolint:revive // only used as `api.Chain`.
 	bc := &blockchain{
-		bp:             host.GetBlockPlugin(),
-		hp:             host.GetHistoricalPlugin(),
-		pp:             host.GetPrecompilePlugin(),
-		spf:            host.GetStatePluginFactory(),
-		config:         config,
-		vmConfig:       &vm.Config{},
-		receiptsCache:  lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
-		blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
-		blockHashCache: lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
-		txLookupCache:  lru.NewCache[common.Hash, *types.TxLookupEntry](defaultCacheSize),
-		chainHeadFeed:  event.Feed{},
-		scope:          event.SubscriptionScope{},
-		logger:         log.Root(),
-		engine:         engine,
+		bp:              host.GetBlockPlugin(),
+		hp:              host.GetHistoricalPlugin(),
+		pp:              host.GetPrecompilePlugin(),
+		wp:              host.GetWithdrawalsPlugin(),
+		spf:             host.GetStatePluginFactory(),
+		config:          config,
+		vmConfig:        &vm.Config{},
+		receiptsCache:   lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
+		blockNumCache:   lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
+		blockHashCache:  lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
+		txLookupCache:   lru.NewCache[common.Hash, *types.TxLookupEntry](defaultCacheSize),
+		badBlocks:       rawdb.NewMemoryDatabase(),
+		badBlockReasons: lru.NewCache[common.Hash, string](badBlockLimit),
+		chainHeadFeed:   event.Feed{},
+		scope:           event.SubscriptionScope{},
+		logger:          log.Root(),
+		engine:          engine,
 	}
 	bc.processor = core.NewStateProcessor(bc.config, bc, bc.engine)
 	bc.validator = core.NewBlockValidator(bc.config, bc, bc.engine)
@@ -145,6 +172,9 @@ func (bc *blockchain) PrimePlugins(ctx context.Context) {
 	if bc.hp != nil {
 		bc.hp.Prepare(ctx)
 	}
+	if bc.wp != nil {
+		bc.wp.Prepare(ctx)
+	}
 }
 
 // LoadLastState loads the last known chain state from the database. This method
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// ChainBadBlocks defines methods that are used to read and report the blocks that were rejected by
+// the chain.
+type ChainBadBlocks interface {
+	ChainDb() ethdb.Database
+	GetBadBlocks() []*ethtypes.Block
+	GetBadBlockReason(common.Hash) string
+	ReportBlock(*ethtypes.Block, error)
+}
+
+// ChainDb returns the database that keeps the bad blocks of the chain. It is bounded to the most
+// recent bad blocks and not persisted, as Polaris keeps no other chain data in it.
+func (bc *blockchain) ChainDb() ethdb.Database { //nolint:stylecheck // conforms to geth.
+	return bc.badBlocks
+}
+
+// GetBadBlocks returns the bad blocks of the chain, sorted in descending order by number.
+func (bc *blockchain) GetBadBlocks() []*ethtypes.Block {
+	return rawdb.ReadAllBadBlocks(bc.badBlocks)
+}
+
+// GetBadBlockReason returns why the bad block with the given hash was rejected, or an empty
+// string if the reason is not known.
+func (bc *blockchain) GetBadBlockReason(hash common.Hash) string {
+	reason, _ := bc.badBlockReasons.Get(hash)
+	return reason
+}
+
+// ReportBlock stores the given block as a bad block, along with the reason it was rejected.
+func (bc *blockchain) ReportBlock(block *ethtypes.Block, err error) {
+	rawdb.WriteBadBlock(bc.badBlocks, block)
+	bc.badBlockReasons.Add(block.Hash(), err.Error())
+	bc.logger.Error("rejected bad block",
+		"num", block.NumberU64(), "hash", block.Hash().Hex(), "err", err)
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// bloomPrefix + number (uint64 big endian) -> log bloom of the block, until its section is
+	// indexed.
+	bloomPrefix = []byte("polaris-bloom-")
+	// bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) -> compressed bloom
+	// bit vector of the bit in the section.
+	bloomBitsPrefix = []byte("polaris-bloombits-")
+	// bloomSectionsKey -> number of indexed sections (uint64 big endian).
+	bloomSectionsKey = []byte("polaris-bloomsections")
+)
+
+// ChainBloomIndex defines methods that are used to build and read the bloom-bits index of the
+// chain.
+type ChainBloomIndex interface {
+	EnableBloomIndex(ethdb.KeyValueStore)
+	BloomStatus() (uint64, uint64)
+	GetBloomBits(uint, uint64) ([]byte, error)
+}
+
+// EnableBloomIndex makes the chain index the log blooms of the finalized blocks into the given
+// database, which is used by the filter system to serve log queries over wide block ranges. The
+// database is not part of consensus, so that the sections of the blocks finalized before it was
+// enabled are indexed from the historical blocks.
+func (bc *blockchain) EnableBloomIndex(db ethdb.KeyValueStore) {
+	bc.blooms = db
+}
+
+// =========================================================================
+// BloomReader
+// =========================================================================
+
+// BloomStatus returns the section size and the number of indexed sections of the bloom-bits
+// index.
+func (bc *blockchain) BloomStatus() (uint64, uint64) {
+	if bc.blooms == nil {
+		return params.BloomBitsBlocks, 0
+	}
+	return params.BloomBitsBlocks, bc.bloomSections()
+}
+
+// GetBloomBits returns the compressed bloom bit vector of the given bit in the given section.
+func (bc *blockchain) GetBloomBits(bit uint, section uint64) ([]byte, error) {
+	if bc.blooms == nil {
+		return nil, ErrBloomBitsNotFound
+	}
+	bits, err := bc.blooms.Get(bloomBitsKey(bit, section))
+	if err != nil {
+		return nil, ErrBloomBitsNotFound
+	}
+	return bits, nil
+}
+
+// =========================================================================
+// BloomWriter
+// =========================================================================
+
+// indexBloom stores the log bloom of the given finalized block and indexes the next section of
+// the bloom-bits index once all of its blocks are finalized. At most one section is indexed per
+// block, so that a chain which enables the index late catches up without stalling finalization.
+func (bc *blockchain) indexBloom(header *ethtypes.Header) error {
+	if bc.blooms == nil {
+		return nil
+	}
+
+	number := header.Number.Uint64()
+	if err := bc.blooms.Put(bloomKey(number), header.Bloom.Bytes()); err != nil {
+		return err
+	}
+
+	section := bc.bloomSections()
+	if (section+1)*params.BloomBitsBlocks > number+1 {
+		return nil
+	}
+	return bc.indexBloomSection(section)
+}
+
+// indexBloomSection generates and stores the bloom bit vectors of the given section, marks the
+// section as indexed and drops the stored log blooms of its blocks, all in one batch.
+func (bc *blockchain) indexBloomSection(section uint64) error {
+	gen, err := bloombits.NewGenerator(uint(params.BloomBitsBlocks))
+	if err != nil {
+		return err
+	}
+	start := section * params.BloomBitsBlocks
+	for i := uint64(0); i < params.BloomBitsBlocks; i++ {
+		var bloom ethtypes.Bloom
+		if bloom, err = bc.bloomAt(start + i); err != nil {
+			return err
+		}
+		if err = gen.AddBloom(uint(i), bloom); err != nil {
+			return err
+		}
+	}
+
+	batch := bc.blooms.NewBatch()
+	for i := 0; i < ethtypes.BloomBitLength; i++ {
+		var bitset []byte
+		if bitset, err = gen.Bitset(uint(i)); err != nil {
+			return err
+		}
+		bits := bitutil.CompressBytes(bitset)
+		if err = batch.Put(bloomBitsKey(uint(i), section), bits); err != nil {
+			return err
+		}
+	}
+	for number := start; number < start+params.BloomBitsBlocks; number++ {
+		if err = batch.Delete(bloomKey(number)); err != nil {
+			return err
+		}
+	}
+	sections := binary.BigEndian.AppendUint64(nil, section+1)
+	if err = batch.Put(bloomSectionsKey, sections); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// bloomAt returns the log bloom of the block at the given number. Blocks that were finalized
+// before the index was enabled have no stored bloom, so it is read from the historical block.
+func (bc *blockchain) bloomAt(number uint64) (ethtypes.Bloom, error) {
+	if bz, err := bc.blooms.Get(bloomKey(number)); err == nil {
+		return ethtypes.BytesToBloom(bz), nil
+	}
+	if bc.hp == nil {
+		return ethtypes.Bloom{}, ErrBloomNotFound
+	}
+	block, err := bc.hp.GetBlockByNumber(number)
+	if err != nil {
+		return ethtypes.Bloom{}, err
+	}
+	return block.Bloom(), nil
+}
+
+// bloomSections returns the number of indexed sections.
+func (bc *blockchain) bloomSections() uint64 {
+	bz, err := bc.blooms.Get(bloomSectionsKey)
+	if err != nil || len(bz) != 8 { //nolint:gomnd // uint64.
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// bloomKey returns the key of the log bloom of the block at the given number.
+func bloomKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, bloomPrefix...), number)
+}
+
+// bloomBitsKey returns the key of the bit vector of the given bit in the given section.
+func bloomBitsKey(bit uint, section uint64) []byte {
+	key := binary.BigEndian.AppendUint16(append([]byte{}, bloomBitsPrefix...), uint16(bit))
+	return binary.BigEndian.AppendUint64(key, section)
+}
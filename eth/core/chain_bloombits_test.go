@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/eth/core/precompile"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bloom Index", func() {
+	var bc *blockchain
+
+	// header returns the header of the block at the given number, of which only the fifth block
+	// has a log.
+	header := func(number uint64) *ethtypes.Header {
+		h := &ethtypes.Header{Number: new(big.Int).SetUint64(number)}
+		if number == 5 {
+			h.Bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{
+				{Address: common.Address{1}},
+			}))
+		}
+		return h
+	}
+
+	BeforeEach(func() {
+		host := &memHost{states: make(map[uint64]*memState), pp: precompile.NewDefaultPlugin()}
+		bc = NewChain(host, params.TestChainConfig, beacon.NewFaker())
+	})
+
+	It("should not index the blooms without a database", func() {
+		Expect(bc.indexBloom(header(0))).To(Succeed())
+		_, sections := bc.BloomStatus()
+		Expect(sections).To(BeZero())
+		_, err := bc.GetBloomBits(0, 0)
+		Expect(err).To(MatchError(ErrBloomBitsNotFound))
+	})
+
+	It("should index a section once all of its blocks are finalized", func() {
+		db := memorydb.New()
+		bc.EnableBloomIndex(db)
+		for number := uint64(0); number < params.BloomBitsBlocks-1; number++ {
+			Expect(bc.indexBloom(header(number))).To(Succeed())
+		}
+		_, sections := bc.BloomStatus()
+		Expect(sections).To(BeZero())
+		_, err := bc.GetBloomBits(0, 0)
+		Expect(err).To(MatchError(ErrBloomBitsNotFound))
+
+		Expect(bc.indexBloom(header(params.BloomBitsBlocks - 1))).To(Succeed())
+		_, sections = bc.BloomStatus()
+		Expect(sections).To(Equal(uint64(1)))
+
+		// Only the fifth block of the section matches any bit of the bloom.
+		var matched byte
+		for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+			compressed, err := bc.GetBloomBits(bit, 0)
+			Expect(err).ToNot(HaveOccurred())
+			bits, err := bitutil.DecompressBytes(compressed, int(params.BloomBitsBlocks/8))
+			Expect(err).ToNot(HaveOccurred())
+			matched |= bits[0]
+		}
+		Expect(matched).To(Equal(byte(1 << (7 - 5))))
+
+		// The blooms of the indexed section are dropped.
+		Expect(db.Len()).To(Equal(ethtypes.BloomBitLength + 1))
+	})
+
+	It("should return the errors of indexing a section", func() {
+		// The blooms of the blocks finalized before the index was enabled are read from the
+		// historical blocks, which the host does not keep.
+		bc.EnableBloomIndex(memorydb.New())
+		Expect(bc.indexBloom(header(params.BloomBitsBlocks - 1))).To(MatchError(ErrBloomNotFound))
+	})
+})
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// ChainPreimages defines methods that are used to record and read the SHA3 preimages computed by
+// the EVM while processing the finalized blocks.
+type ChainPreimages interface {
+	EnablePreimageRecording(ethdb.KeyValueStore)
+	Preimage(common.Hash) []byte
+}
+
+// EnablePreimageRecording makes the EVM record the SHA3 preimages it computes and the chain
+// persist the ones of the finalized blocks into the given database. The database is not part of
+// consensus, so it only holds the preimages of the blocks finalized since it was enabled.
+func (bc *blockchain) EnablePreimageRecording(db ethdb.KeyValueStore) {
+	bc.preimages = db
+	bc.vmConfig.EnablePreimageRecording = true
+}
+
+// Preimage returns the preimage of the given hash, or nil if it is not known.
+func (bc *blockchain) Preimage(hash common.Hash) []byte {
+	if bc.preimages == nil {
+		return nil
+	}
+	return rawdb.ReadPreimage(bc.preimages, hash)
+}
+
+// writePreimages persists the preimages recorded by the given state, if preimage recording is
+// enabled.
+func (bc *blockchain) writePreimages(state state.StateDB) {
+	if bc.preimages == nil || len(state.Preimages()) == 0 {
+		return
+	}
+	rawdb.WritePreimages(bc.preimages, state.Preimages())
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/berachain/polaris/eth/core/state"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
 )
 
 // ChainResources is the interface that defines functions for code paths within the chain to
@@ -42,6 +44,8 @@ type ChainResources interface {
 	StateAtBlockNumber(uint64) (state.StateDB, error)
 	StateAt(root common.Hash) (state.StateDB, error)
 	GetOverridenState() (state.StateDB, error)
+	StateForSimulation(uint64) (state.StateDB, error)
+	SetMiningTime(uint64)
 
 	// state for tracing
 	StateAtBlock(
@@ -58,14 +62,39 @@ type ChainResources interface {
 	Config() *params.ChainConfig
 }
 
-// StateAt returns a statedb configured to read what the state of the blockchain is/was at a given.
-func (bc *blockchain) StateAt(common.Hash) (state.StateDB, error) {
-	return nil, errors.New("StateAt is not implemented in polaris due state root")
+// StateAt returns a statedb configured to read what the state of the blockchain was after the
+// latest block with the given state root.
+//
+// NOTE: the state after the current head block is not served by root, since execution on top of
+// the head must see the state at the beginning of the next block (i.e. with the host chain's
+// pre-block changes applied). The miner falls back to `GetOverridenState` when this returns an
+// error, while the txpools resolve the head root to `StateAtBlockNumber` of the next block.
+func (bc *blockchain) StateAt(root common.Hash) (state.StateDB, error) {
+	header, err := bc.bp.GetHeaderByStateRoot(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if head := bc.CurrentBlock(); head != nil && header.Number.Cmp(head.Number) >= 0 {
+		return nil, ErrStateAtHead
+	}
+	return bc.StateAtBlockNumber(header.Number.Uint64())
 }
 
-// Used by geth miner to build the block (can rename to GetMinerState).
+// Used by geth miner to build the block (can rename to GetMinerState). The precompiles of the
+// state are the ones active in the block built on top of the current block.
 func (bc *blockchain) GetOverridenState() (state.StateDB, error) {
-	return state.NewStateDB(bc.spf.NewPluginWithMode(state.Miner), bc.pp), nil
+	statedb := state.NewStateDB(bc.spf.NewPluginWithMode(state.Miner), bc.pp)
+	if head := bc.CurrentBlock(); head != nil {
+		state.BindBlock(statedb, new(big.Int).Add(head.Number, common.Big1), bc.miningTime.Load())
+	}
+	return statedb, nil
+}
+
+// SetMiningTime sets the timestamp of the block that the miner builds on top of the current
+// block, as the host chain decides it before the block is built.
+func (bc *blockchain) SetMiningTime(time uint64) {
+	bc.miningTime.Store(time)
 }
 
 // StateAtBlockNumber returns a statedb configured to read what the state of the blockchain is/was
@@ -79,6 +108,16 @@ func (bc *blockchain) StateAtBlockNumber(number uint64) (state.StateDB, error) {
 	return state.NewStateDB(sp, bc.pp), nil
 }
 
+// StateForSimulation returns a statedb to simulate calls on top of the given block number. Calls
+// on top of the head block are served from the simulation mode of the state plugin, so that every
+// call reads its own branch of the state, isolated from block processing and from other calls.
+func (bc *blockchain) StateForSimulation(number uint64) (state.StateDB, error) {
+	if head := bc.CurrentBlock(); head == nil || number < head.Number.Uint64() {
+		return bc.StateAtBlockNumber(number)
+	}
+	return state.NewStateDB(bc.spf.NewPluginWithMode(state.Simulation), bc.pp), nil
+}
+
 // HasBlockAndState checks if the blockchain has a block and its state at
 // a given hash and number.
 func (bc *blockchain) HasBlockAndState(hash common.Hash, number uint64) bool {
@@ -123,25 +162,85 @@ func (bc *blockchain) HasBlockAndState(hash common.Hash, number uint64) bool {
 //     provided, it would be preferable to start from a fresh state, if we have it
 //     on disk.
 func (bc *blockchain) StateAtBlock(
-	_ context.Context, block *ethtypes.Block, _ uint64,
+	ctx context.Context, block *ethtypes.Block, reexec uint64,
 	_ state.StateDB, _ bool, _ bool,
 ) (state.StateDB, tracers.StateReleaseFunc, error) {
-	// Check if the requested state is available in the live chain.
-	statedb, err := bc.StateAtBlockNumber(block.Number().Uint64())
-	if err != nil {
-		// If there is an error, it means the state is not available.
-		// TODO: Historic state is not supported in path-based scheme.
-		// Fully archive node in pbss will be implemented by relying
-		// on state history, but needs more work on top.
-		return nil, nil, errors.Join(
-			err, errors.New("historical state not available in path scheme yet"),
-		)
+	// Check if the requested state is available in the live chain. The state is isolated, so that
+	// the state overrides of traced calls never leak.
+	statedb, err := bc.StateForSimulation(block.Number().Uint64())
+	if err == nil {
+		// If there is no error, return the state, a no-op function, and no error.
+		return statedb, func() {}, nil
 	}
 
-	// If there is no error, return the state, a no-op function, and no error.
+	// If there is an error, it means the host chain has pruned the state, so regenerate it by
+	// re-executing the blocks on top of the nearest retained state.
+	if statedb, err = bc.regenerateState(ctx, block, reexec); err != nil {
+		return nil, nil, err
+	}
 	return statedb, func() {}, nil
 }
 
+// regenerateState finds the nearest state retained by the host chain, at most `reexec` blocks
+// before the given block, and re-executes the historical blocks on top of it to regenerate the
+// state after the given block. The retained state is loaded in a throwaway context of the host
+// chain, so the re-executed blocks are never persisted.
+//
+// The receipts hash of every re-executed block is checked against its header, so the
+// transactions of the regenerated blocks are known to behave as they did historically.
+//
+// NOTE: only the EVM blocks are re-executed, so changes that the host chain made to the state
+// outside of EVM blocks (e.g. in its begin and end blockers), between the retained state and the
+// given block, are not regenerated. The regenerated state is therefore an approximation of the
+// historical one, and its root is not checked against the headers of the re-executed blocks.
+func (bc *blockchain) regenerateState(
+	ctx context.Context, block *ethtypes.Block, reexec uint64,
+) (state.StateDB, error) {
+	var (
+		number  = block.NumberU64()
+		statedb state.StateDB
+		err     error
+	)
+
+	// Find the nearest retained state, walking back at most `reexec` blocks.
+	start := number
+	for start > 0 && number-start < reexec {
+		start--
+		if statedb, err = bc.StateAtBlockNumber(start); err == nil {
+			break
+		}
+	}
+	if statedb == nil {
+		return nil, fmt.Errorf(
+			"%w: no state retained within %d blocks before block %d", ErrStateNotFound, reexec, number,
+		)
+	}
+
+	// Re-execute the blocks after the retained state, up to and including the given block.
+	for current := start + 1; current <= number; current++ {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		next := block
+		if current != number {
+			if next = bc.GetBlockByNumber(current); next == nil {
+				return nil, fmt.Errorf("%w: block %d", ErrBlockNotFound, current)
+			}
+		}
+		state.BindBlock(statedb, next.Number(), next.Time())
+		receipts, _, _, procErr := bc.processor.Process(next, statedb, *bc.vmConfig)
+		if procErr != nil {
+			return nil, fmt.Errorf("failed to re-execute block %d: %w", current, procErr)
+		}
+		if hash := ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)); hash != next.ReceiptHash() {
+			return nil, fmt.Errorf(
+				"%w: block %d, have %s, want %s", ErrInvalidReceiptHash, current, hash, next.ReceiptHash(),
+			)
+		}
+	}
+	return statedb, nil
+}
+
 // StateAtTransaction returns the execution environment of a certain transaction.
 func (bc *blockchain) StateAtTransaction(
 	ctx context.Context, block *ethtypes.Block,
@@ -162,6 +261,8 @@ func (bc *blockchain) StateAtTransaction(
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, nil, err
 	}
+	// The transactions of the block are executed with the precompiles active in the block.
+	state.BindBlock(statedb, block.Number(), block.Time())
 	if txIndex == 0 && len(block.Transactions()) == 0 {
 		return nil, vm.BlockContext{}, statedb, release, nil
 	}
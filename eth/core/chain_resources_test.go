@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regenerate State", func() {
+	const (
+		numBlocks = 8
+		retained  = 2
+	)
+
+	var (
+		bc     *blockchain
+		host   *memHost
+		blocks []*ethtypes.Block
+		key, _ = crypto.GenerateKey()
+		sender = crypto.PubkeyToAddress(key.PublicKey)
+	)
+
+	BeforeEach(func() {
+		host = &memHost{states: make(map[uint64]*memState), pp: precompile.NewDefaultPlugin()}
+		bc = NewChain(host, params.TestChainConfig, beacon.NewFaker())
+
+		// Execute the blocks on the live state, recording the state after every block.
+		live := newMemState()
+		live.balances[sender] = big.NewInt(1e18)
+		host.states[0] = live.copy()
+		signer := ethtypes.LatestSigner(params.TestChainConfig)
+		parent := &ethtypes.Header{Number: new(big.Int), BaseFee: new(big.Int)}
+		blocks = nil
+		for i := uint64(0); i < numBlocks; i++ {
+			tx := ethtypes.MustSignNewTx(key, signer, &ethtypes.LegacyTx{
+				Nonce: i, To: &common.Address{byte(i + 1)}, Value: big.NewInt(int64(i + 1)), Gas: 21000,
+			})
+			header := &ethtypes.Header{
+				ParentHash: parent.Hash(),
+				Number:     new(big.Int).Add(parent.Number, common.Big1),
+				Time:       i + 1,
+				GasLimit:   1e7,
+				BaseFee:    new(big.Int),
+				Difficulty: new(big.Int),
+			}
+			block := ethtypes.NewBlockWithHeader(header).WithBody([]*ethtypes.Transaction{tx}, nil)
+			statedb := state.NewStateDB(live, host.pp)
+			receipts, _, _, err := bc.processor.Process(block, statedb, *bc.vmConfig)
+			Expect(err).ToNot(HaveOccurred())
+			header.GasUsed = receipts[0].CumulativeGasUsed
+			header.Root = statedb.IntermediateRoot(true)
+			block = ethtypes.NewBlock(header, block.Transactions(), nil, receipts, trie.NewStackTrie(nil))
+
+			host.states[block.NumberU64()] = live.copy()
+			bc.blockNumCache.Add(block.NumberU64(), block)
+			blocks = append(blocks, block)
+			parent = header
+		}
+		bc.currentBlock.Store(blocks[numBlocks-1])
+
+		// Prune the states after the retained block.
+		for number := uint64(retained + 1); number <= numBlocks; number++ {
+			delete(host.states, number)
+		}
+	})
+
+	It("should replay the blocks up to the root of the requested block", func() {
+		target := blocks[numBlocks-2]
+		statedb, release, err := bc.StateAtBlock(
+			context.Background(), target, numBlocks, nil, true, false,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		defer release()
+		Expect(statedb.IntermediateRoot(true)).To(Equal(target.Root()))
+		Expect(statedb.GetNonce(sender)).To(Equal(target.NumberU64()))
+	})
+
+	It("should respect the reexec limit", func() {
+		target := blocks[numBlocks-2]
+		_, _, err := bc.StateAtBlock(context.Background(), target, 1, nil, true, false)
+		Expect(errors.Is(err, ErrStateNotFound)).To(BeTrue())
+	})
+
+	It("should regenerate the state despite changes made outside of the blocks", func() {
+		// Change the balances outside of the blocks, as the begin and end blockers of the host
+		// chain would.
+		retainedState := host.states[retained]
+		retainedState.balances[common.Address{0xff}] = big.NewInt(1)
+		retainedState.balances[sender].Add(retainedState.balances[sender], common.Big1)
+		target := blocks[numBlocks-2]
+		statedb, release, err := bc.StateAtBlock(
+			context.Background(), target, numBlocks, nil, true, false,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		defer release()
+
+		// The regenerated state is approximate, as the changes are carried over from the
+		// retained state instead of being replayed.
+		Expect(statedb.IntermediateRoot(true)).ToNot(Equal(target.Root()))
+		Expect(statedb.GetBalance(common.Address{0xff})).To(Equal(big.NewInt(1)))
+		Expect(statedb.GetNonce(sender)).To(Equal(target.NumberU64()))
+	})
+
+	It("should fail if the regenerated receipts do not match the header", func() {
+		target := blocks[retained]
+		header := target.Header()
+		header.ReceiptHash = common.Hash{1}
+		bc.blockNumCache.Add(target.NumberU64(), target.WithSeal(header))
+		_, _, err := bc.StateAtBlock(
+			context.Background(), blocks[numBlocks-2], numBlocks, nil, true, false,
+		)
+		Expect(errors.Is(err, ErrInvalidReceiptHash)).To(BeTrue())
+	})
+})
+
+// memHost is a host chain which only serves the states of the retained blocks.
+type memHost struct {
+	states map[uint64]*memState
+	pp     PrecompilePlugin
+}
+
+func (h *memHost) GetBlockPlugin() BlockPlugin                      { return nil }
+func (h *memHost) GetHistoricalPlugin() HistoricalPlugin            { return nil }
+func (h *memHost) GetPrecompilePlugin() PrecompilePlugin            { return h.pp }
+func (h *memHost) GetWithdrawalsPlugin() WithdrawalsPlugin          { return nil }
+func (h *memHost) GetStatePluginFactory() StatePluginFactory        { return h }
+func (h *memHost) Version() string                                  { return "" }
+func (h *memHost) NewPluginWithMode(state.Mode) StatePlugin         { return nil }
+func (h *memHost) NewPluginFromContext(context.Context) StatePlugin { return nil }
+func (h *memHost) SetLatestQueryContext(context.Context)            {}
+func (h *memHost) SetLatestMiningContext(context.Context)           {}
+func (h *memHost) SetInsertChainContext(context.Context)            {}
+
+func (h *memHost) NewPluginAtBlockNumber(number int64) (StatePlugin, error) {
+	sp, ok := h.states[uint64(number)]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	// The state is copied, so that execution on top of it is thrown away.
+	return sp.copy(), nil
+}
+
+// memState is an in-memory state of accounts with balances and nonces only.
+type memState struct {
+	balances map[common.Address]*big.Int
+	nonces   map[common.Address]uint64
+}
+
+func newMemState() *memState {
+	return &memState{
+		balances: make(map[common.Address]*big.Int),
+		nonces:   make(map[common.Address]uint64),
+	}
+}
+
+func (s *memState) copy() *memState {
+	cpy := newMemState()
+	for addr, balance := range s.balances {
+		cpy.balances[addr] = new(big.Int).Set(balance)
+	}
+	for addr, nonce := range s.nonces {
+		cpy.nonces[addr] = nonce
+	}
+	return cpy
+}
+
+// StateRoot commits to the sorted accounts of the state.
+func (s *memState) StateRoot() common.Hash {
+	addrs := make([]common.Address, 0, len(s.balances))
+	for addr := range s.balances {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	var buf []byte
+	for _, addr := range addrs {
+		buf = append(buf, addr[:]...)
+		buf = append(buf, common.BigToHash(s.balances[addr]).Bytes()...)
+		buf = append(buf, new(big.Int).SetUint64(s.nonces[addr]).Bytes()...)
+	}
+	return crypto.Keccak256Hash(buf)
+}
+
+func (s *memState) StateAtBlockNumber(uint64) (StatePlugin, error) { return nil, ErrStateNotFound }
+func (s *memState) Snapshot() int                                  { return 0 }
+func (s *memState) RevertToSnapshot(int)                           {}
+func (s *memState) RegistryKey() string                            { return "state" }
+func (s *memState) Finalize()                                      {}
+func (s *memState) Reset(context.Context)                          {}
+func (s *memState) Clone() state.Plugin                            { return s.copy() }
+func (s *memState) GetContext() context.Context                    { return context.Background() }
+func (s *memState) Error() error                                   { return nil }
+func (s *memState) DeleteAccounts([]common.Address)                {}
+
+func (s *memState) CreateAccount(addr common.Address) {
+	if _, ok := s.balances[addr]; !ok {
+		s.balances[addr] = new(big.Int)
+	}
+}
+
+func (s *memState) Exist(addr common.Address) bool {
+	_, ok := s.balances[addr]
+	return ok
+}
+
+func (s *memState) Empty(addr common.Address) bool {
+	return s.GetBalance(addr).Sign() == 0 && s.nonces[addr] == 0
+}
+
+func (s *memState) GetBalance(addr common.Address) *big.Int {
+	if balance, ok := s.balances[addr]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return new(big.Int)
+}
+
+func (s *memState) SetBalance(addr common.Address, amount *big.Int) {
+	s.balances[addr] = new(big.Int).Set(amount)
+}
+
+func (s *memState) AddBalance(addr common.Address, amount *big.Int) {
+	s.SetBalance(addr, new(big.Int).Add(s.GetBalance(addr), amount))
+}
+
+func (s *memState) SubBalance(addr common.Address, amount *big.Int) {
+	s.SetBalance(addr, new(big.Int).Sub(s.GetBalance(addr), amount))
+}
+
+func (s *memState) GetNonce(addr common.Address) uint64 { return s.nonces[addr] }
+
+func (s *memState) SetNonce(addr common.Address, nonce uint64) {
+	s.CreateAccount(addr)
+	s.nonces[addr] = nonce
+}
+
+func (s *memState) GetCodeHash(addr common.Address) common.Hash {
+	if !s.Exist(addr) {
+		return common.Hash{}
+	}
+	return ethtypes.EmptyCodeHash
+}
+
+func (s *memState) GetCode(common.Address) []byte                          { return nil }
+func (s *memState) SetCode(common.Address, []byte)                         {}
+func (s *memState) GetState(common.Address, common.Hash) common.Hash       { return common.Hash{} }
+func (s *memState) SetState(common.Address, common.Hash, common.Hash)      {}
+func (s *memState) SetStorage(common.Address, map[common.Hash]common.Hash) {}
+
+func (s *memState) GetCommittedState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+
+func (s *memState) ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error {
+	return nil
+}
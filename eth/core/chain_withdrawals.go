@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// ChainWithdrawalsReader defines methods that are used to read the withdrawals queued by the
+// host chain.
+type ChainWithdrawalsReader interface {
+	PendingWithdrawals() (ethtypes.Withdrawals, error)
+}
+
+// =========================================================================
+// WithdrawalsReader
+// =========================================================================
+
+// PendingWithdrawals returns the withdrawals queued by the host chain that the next block must
+// include. It returns no withdrawals if the host chain does not implement the WithdrawalsPlugin.
+func (bc *blockchain) PendingWithdrawals() (ethtypes.Withdrawals, error) {
+	if bc.wp == nil {
+		return make(ethtypes.Withdrawals, 0), nil
+	}
+	return bc.wp.PendingWithdrawals()
+}
+
+// =========================================================================
+// WithdrawalsWriter
+// =========================================================================
+
+// validateWithdrawals checks that the given block includes exactly the withdrawals queued by the
+// host chain, so that a proposer can neither mint nor skip any credit.
+func (bc *blockchain) validateWithdrawals(block *ethtypes.Block) error {
+	if !bc.config.IsShanghai(block.Number(), block.Time()) {
+		return nil
+	}
+
+	expected, err := bc.PendingWithdrawals()
+	if err != nil {
+		return err
+	}
+	have := ethtypes.Withdrawals(block.Withdrawals())
+	if ethtypes.DeriveSha(have, trie.NewStackTrie(nil)) !=
+		ethtypes.DeriveSha(expected, trie.NewStackTrie(nil)) {
+		return fmt.Errorf(
+			"%w: have %d withdrawals, want %d", ErrInvalidWithdrawals, len(have), len(expected),
+		)
+	}
+	return nil
+}
+
+// processWithdrawals dequeues the withdrawals credited by the given finalized block from the
+// host chain.
+func (bc *blockchain) processWithdrawals(block *ethtypes.Block) error {
+	if bc.wp == nil || len(block.Withdrawals()) == 0 {
+		return nil
+	}
+	return bc.wp.ProcessWithdrawals(block.Withdrawals())
+}
@@ -49,15 +49,17 @@ func (bc *blockchain) InsertBlock(block *ethtypes.Block) error {
 	state := state.NewStateDB(sp, bc.pp)
 
 	// Call the private method to insert the block and setting it as the head.
-	_, _, err := bc.insertBlock(block, state)
-	// Return any error that might have occurred.
-	return err
+	if _, _, err := bc.insertBlock(block, state); err != nil {
+		bc.ReportBlock(block, err)
+		return err
+	}
+	return nil
 }
 
 // insertBlock inserts a block into the blockchain by running the state processor and
 // validating whether its okay.
 func (bc *blockchain) insertBlock(
-	block *ethtypes.Block, state state.StateDB,
+	block *ethtypes.Block, statedb state.StateDB,
 ) ([]*ethtypes.Receipt, []*ethtypes.Log, error) {
 	// Validate that we are about to insert a valid block.
 	// If the block number is greater than 1,
@@ -69,15 +71,22 @@ func (bc *blockchain) insertBlock(
 		}
 	}
 
-	// Process the incoming EVM block.
-	receipts, logs, usedGas, err := bc.processor.Process(block, state, *bc.vmConfig)
+	// Validate that the block credits exactly the withdrawals queued by the host chain.
+	if err := bc.validateWithdrawals(block); err != nil {
+		log.Error("invalid block withdrawals", "err", err)
+		return nil, nil, err
+	}
+
+	// Process the incoming EVM block with the precompiles that are active in the block.
+	state.BindBlock(statedb, block.Number(), block.Time())
+	receipts, logs, usedGas, err := bc.processor.Process(block, statedb, *bc.vmConfig)
 	if err != nil {
 		log.Error("failed to process block", "num", block.NumberU64(), "err", err)
 		return nil, nil, err
 	}
 
 	// ValidateState validates the statedb post block processing.
-	if err = bc.validator.ValidateState(block, state, receipts, usedGas); err != nil {
+	if err = bc.validator.ValidateState(block, statedb, receipts, usedGas); err != nil {
 		log.Error("invalid state after processing block", "num", block.NumberU64(), "err", err)
 		return nil, nil, err
 	}
@@ -93,12 +102,14 @@ func (bc *blockchain) InsertBlockAndSetHead(block *ethtypes.Block) error {
 
 	receipts, logs, err := bc.insertBlock(block, state)
 	if err != nil {
+		bc.ReportBlock(block, err)
 		return err
 	}
 	// We can just immediately finalize the block. It's okay in this context.
 	if _, err = bc.WriteBlockAndSetHead(
 		block, receipts, logs, state, true); err != nil {
 		log.Error("failed to write block", "num", block.NumberU64(), "err", err)
+		bc.ReportBlock(block, err)
 		return err
 	}
 	return err
@@ -185,6 +196,15 @@ func (bc *blockchain) writeBlockWithState(
 		return err
 	}
 
+	// Persist the preimages recorded while processing the block.
+	bc.writePreimages(state)
+
+	// Dequeue the withdrawals credited by the block from the host chain.
+	if err = bc.processWithdrawals(block); err != nil {
+		bc.logger.Error("failed to process withdrawals", "err", err)
+		return err
+	}
+
 	// Commit all cached state changes into underlying memory database.
 	// In Polaris this is a no-op.
 	_, err = state.Commit(block.NumberU64(), bc.config.IsEIP158(block.Number()))
@@ -221,6 +241,10 @@ func (bc *blockchain) writeHistoricalData(
 			bc.logger.Error("failed to store transactions", "err", err)
 			return err
 		}
+		if err = bc.indexBloom(block.Header()); err != nil {
+			bc.logger.Error("failed to index bloom", "err", err)
+			return err
+		}
 	}
 
 	return nil
@@ -23,9 +23,15 @@ package core
 import "errors"
 
 var (
-	ErrBlockOutOfGas    = errors.New("block is out of gas")
-	ErrBlockNotFound    = errors.New("block not found")
-	ErrHeaderNotFound   = errors.New("header not found")
-	ErrReceiptsNotFound = errors.New("receipts not found")
-	ErrTxNotFound       = errors.New("transaction not found")
+	ErrBlockOutOfGas      = errors.New("block is out of gas")
+	ErrBlockNotFound      = errors.New("block not found")
+	ErrHeaderNotFound     = errors.New("header not found")
+	ErrReceiptsNotFound   = errors.New("receipts not found")
+	ErrTxNotFound         = errors.New("transaction not found")
+	ErrStateAtHead        = errors.New("state after the head block is not available by root")
+	ErrBloomNotFound      = errors.New("bloom not found")
+	ErrBloomBitsNotFound  = errors.New("bloom bits not found")
+	ErrStateNotFound      = errors.New("historical state not available")
+	ErrInvalidWithdrawals = errors.New("block withdrawals do not match the queued withdrawals")
+	ErrInvalidReceiptHash = errors.New("receipts hash does not match the block header")
 )
@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type (
@@ -53,6 +54,14 @@ var DefaultGenesis = &core.Genesis{
 		common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"): {
 			Balance: big.NewInt(0).Mul(big.NewInt(5e18), big.NewInt(100)), //nolint:gomnd // its okay.
 		},
+		// EIP-4788 beacon roots contract, called at the start of every Cancun block.
+		params.BeaconRootsStorageAddress: {
+			Balance: big.NewInt(0),
+			Code: hexutil.MustDecode(
+				"0x3373fffffffffffffffffffffffffffffffffffffffe14604d57602036146024575f5ffd5b5f" +
+					"35801560495762001fff810690815414603c575f5ffd5b62001fff01545f5260205ff35b5f5f" +
+					"fd5b62001fff42064281555f359062001fff015500"),
+		},
 	},
 
 	// These fields are used for consensus tests. Please don't use them
@@ -82,4 +91,6 @@ func UnmarshalGenesisHeader(header *ethtypes.Header, gen *Genesis) {
 	gen.Mixhash = header.MixDigest
 	gen.Coinbase = header.Coinbase
 	gen.Number = header.Number.Uint64()
+	gen.ExcessBlobGas = header.ExcessBlobGas
+	gen.BlobGasUsed = header.BlobGasUsed
 }
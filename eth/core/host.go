@@ -40,6 +40,8 @@ type PolarisHostChain interface {
 	GetHistoricalPlugin() HistoricalPlugin
 	// GetPrecompilePlugin returns the OPTIONAL `PrecompilePlugin` of the Polaris host chain.
 	GetPrecompilePlugin() PrecompilePlugin
+	// GetWithdrawalsPlugin returns the OPTIONAL `WithdrawalsPlugin` of the Polaris host chain.
+	GetWithdrawalsPlugin() WithdrawalsPlugin
 	// GetStatePlugin returns the `StatePlugin` of the Polaris host chain.
 	GetStatePluginFactory() StatePluginFactory
 	// Version()
@@ -63,6 +65,8 @@ type (
 		GetHeaderByNumber(uint64) (*ethtypes.Header, error)
 		// GetHeaderByHash returns the block header with the given block hash.
 		GetHeaderByHash(common.Hash) (*ethtypes.Header, error)
+		// GetHeaderByStateRoot returns the latest block header with the given state root.
+		GetHeaderByStateRoot(common.Hash) (*ethtypes.Header, error)
 		// StoreHeader stores the block header at the given block number.
 		StoreHeader(*ethtypes.Header) error
 	}
@@ -72,8 +76,6 @@ type (
 		state.Plugin
 		// StateAtBlockNumber returns the state at the given block height.
 		StateAtBlockNumber(uint64) (StatePlugin, error)
-		SetStateOverride(ctx context.Context)
-		GetOverridenState() StatePlugin
 	}
 
 	StatePluginFactory interface {
@@ -116,6 +118,20 @@ type (
 		StoreTransactions(uint64, common.Hash, ethtypes.Transactions) error
 	}
 
+	// WithdrawalsPlugin defines the methods that the chain running Polaris EVM should implement
+	// in order to credit EVM accounts without a transaction, such as for matured unbondings or
+	// bridge releases. The queued credits are included in the blocks as EIP-4895 withdrawals.
+	// Implementing this plugin is optional.
+	WithdrawalsPlugin interface {
+		// WithdrawalsPlugin implements `libtypes.Preparable`.
+		libtypes.Preparable
+		// PendingWithdrawals returns the queued withdrawals, in order, that the next block must
+		// include.
+		PendingWithdrawals() (ethtypes.Withdrawals, error)
+		// ProcessWithdrawals dequeues the given withdrawals once their block is finalized.
+		ProcessWithdrawals(ethtypes.Withdrawals) error
+	}
+
 	// PrecompilePlugin defines the methods that the chain running Polaris EVM should implement
 	// in order to support running their own stateful precompiled contracts. Implementing this
 	// plugin is optional.
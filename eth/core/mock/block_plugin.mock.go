@@ -27,6 +27,9 @@ var _ core.BlockPlugin = &BlockPluginMock{}
 //			GetHeaderByNumberFunc: func(v uint64) (*ethtypes.Header, error) {
 //				panic("mock out the GetHeaderByNumber method")
 //			},
+//			GetHeaderByStateRootFunc: func(hash common.Hash) (*ethtypes.Header, error) {
+//				panic("mock out the GetHeaderByStateRoot method")
+//			},
 //			PrepareFunc: func(contextMoqParam context.Context)  {
 //				panic("mock out the Prepare method")
 //			},
@@ -46,6 +49,9 @@ type BlockPluginMock struct {
 	// GetHeaderByNumberFunc mocks the GetHeaderByNumber method.
 	GetHeaderByNumberFunc func(v uint64) (*ethtypes.Header, error)
 
+	// GetHeaderByStateRootFunc mocks the GetHeaderByStateRoot method.
+	GetHeaderByStateRootFunc func(hash common.Hash) (*ethtypes.Header, error)
+
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
 
@@ -64,6 +70,11 @@ type BlockPluginMock struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// GetHeaderByStateRoot holds details about calls to the GetHeaderByStateRoot method.
+		GetHeaderByStateRoot []struct {
+			// Hash is the hash argument value.
+			Hash common.Hash
+		}
 		// Prepare holds details about calls to the Prepare method.
 		Prepare []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -75,10 +86,11 @@ type BlockPluginMock struct {
 			Header *ethtypes.Header
 		}
 	}
-	lockGetHeaderByHash   sync.RWMutex
-	lockGetHeaderByNumber sync.RWMutex
-	lockPrepare           sync.RWMutex
-	lockStoreHeader       sync.RWMutex
+	lockGetHeaderByHash      sync.RWMutex
+	lockGetHeaderByNumber    sync.RWMutex
+	lockGetHeaderByStateRoot sync.RWMutex
+	lockPrepare              sync.RWMutex
+	lockStoreHeader          sync.RWMutex
 }
 
 // GetHeaderByHash calls GetHeaderByHashFunc.
@@ -145,6 +157,38 @@ func (mock *BlockPluginMock) GetHeaderByNumberCalls() []struct {
 	return calls
 }
 
+// GetHeaderByStateRoot calls GetHeaderByStateRootFunc.
+func (mock *BlockPluginMock) GetHeaderByStateRoot(hash common.Hash) (*ethtypes.Header, error) {
+	if mock.GetHeaderByStateRootFunc == nil {
+		panic("BlockPluginMock.GetHeaderByStateRootFunc: method is nil but BlockPlugin.GetHeaderByStateRoot was just called")
+	}
+	callInfo := struct {
+		Hash common.Hash
+	}{
+		Hash: hash,
+	}
+	mock.lockGetHeaderByStateRoot.Lock()
+	mock.calls.GetHeaderByStateRoot = append(mock.calls.GetHeaderByStateRoot, callInfo)
+	mock.lockGetHeaderByStateRoot.Unlock()
+	return mock.GetHeaderByStateRootFunc(hash)
+}
+
+// GetHeaderByStateRootCalls gets all the calls that were made to GetHeaderByStateRoot.
+// Check the length with:
+//
+//	len(mockedBlockPlugin.GetHeaderByStateRootCalls())
+func (mock *BlockPluginMock) GetHeaderByStateRootCalls() []struct {
+	Hash common.Hash
+} {
+	var calls []struct {
+		Hash common.Hash
+	}
+	mock.lockGetHeaderByStateRoot.RLock()
+	calls = mock.calls.GetHeaderByStateRoot
+	mock.lockGetHeaderByStateRoot.RUnlock()
+	return calls
+}
+
 // Prepare calls PrepareFunc.
 func (mock *BlockPluginMock) Prepare(contextMoqParam context.Context) {
 	if mock.PrepareFunc == nil {
@@ -67,9 +67,6 @@ var _ core.StatePlugin = &StatePluginMock{}
 //			GetNonceFunc: func(address common.Address) uint64 {
 //				panic("mock out the GetNonce method")
 //			},
-//			GetOverridenStateFunc: func() core.StatePlugin {
-//				panic("mock out the GetOverridenState method")
-//			},
 //			GetStateFunc: func(address common.Address, hash common.Hash) common.Hash {
 //				panic("mock out the GetState method")
 //			},
@@ -94,9 +91,6 @@ var _ core.StatePlugin = &StatePluginMock{}
 //			SetStateFunc: func(address common.Address, hash1 common.Hash, hash2 common.Hash)  {
 //				panic("mock out the SetState method")
 //			},
-//			SetStateOverrideFunc: func(ctx context.Context)  {
-//				panic("mock out the SetStateOverride method")
-//			},
 //			SetStorageFunc: func(addr common.Address, storage map[common.Hash]common.Hash)  {
 //				panic("mock out the SetStorage method")
 //			},
@@ -106,6 +100,9 @@ var _ core.StatePlugin = &StatePluginMock{}
 //			StateAtBlockNumberFunc: func(v uint64) (core.StatePlugin, error) {
 //				panic("mock out the StateAtBlockNumber method")
 //			},
+//			StateRootFunc: func() common.Hash {
+//				panic("mock out the StateRoot method")
+//			},
 //			SubBalanceFunc: func(address common.Address, intMoqParam *big.Int)  {
 //				panic("mock out the SubBalance method")
 //			},
@@ -161,9 +158,6 @@ type StatePluginMock struct {
 	// GetNonceFunc mocks the GetNonce method.
 	GetNonceFunc func(address common.Address) uint64
 
-	// GetOverridenStateFunc mocks the GetOverridenState method.
-	GetOverridenStateFunc func() core.StatePlugin
-
 	// GetStateFunc mocks the GetState method.
 	GetStateFunc func(address common.Address, hash common.Hash) common.Hash
 
@@ -188,9 +182,6 @@ type StatePluginMock struct {
 	// SetStateFunc mocks the SetState method.
 	SetStateFunc func(address common.Address, hash1 common.Hash, hash2 common.Hash)
 
-	// SetStateOverrideFunc mocks the SetStateOverride method.
-	SetStateOverrideFunc func(ctx context.Context)
-
 	// SetStorageFunc mocks the SetStorage method.
 	SetStorageFunc func(addr common.Address, storage map[common.Hash]common.Hash)
 
@@ -200,6 +191,9 @@ type StatePluginMock struct {
 	// StateAtBlockNumberFunc mocks the StateAtBlockNumber method.
 	StateAtBlockNumberFunc func(v uint64) (core.StatePlugin, error)
 
+	// StateRootFunc mocks the StateRoot method.
+	StateRootFunc func() common.Hash
+
 	// SubBalanceFunc mocks the SubBalance method.
 	SubBalanceFunc func(address common.Address, intMoqParam *big.Int)
 
@@ -278,9 +272,6 @@ type StatePluginMock struct {
 			// Address is the address argument value.
 			Address common.Address
 		}
-		// GetOverridenState holds details about calls to the GetOverridenState method.
-		GetOverridenState []struct {
-		}
 		// GetState holds details about calls to the GetState method.
 		GetState []struct {
 			// Address is the address argument value.
@@ -331,11 +322,6 @@ type StatePluginMock struct {
 			// Hash2 is the hash2 argument value.
 			Hash2 common.Hash
 		}
-		// SetStateOverride holds details about calls to the SetStateOverride method.
-		SetStateOverride []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-		}
 		// SetStorage holds details about calls to the SetStorage method.
 		SetStorage []struct {
 			// Addr is the addr argument value.
@@ -351,6 +337,9 @@ type StatePluginMock struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// StateRoot holds details about calls to the StateRoot method.
+		StateRoot []struct {
+		}
 		// SubBalance holds details about calls to the SubBalance method.
 		SubBalance []struct {
 			// Address is the address argument value.
@@ -374,7 +363,6 @@ type StatePluginMock struct {
 	lockGetCommittedState  sync.RWMutex
 	lockGetContext         sync.RWMutex
 	lockGetNonce           sync.RWMutex
-	lockGetOverridenState  sync.RWMutex
 	lockGetState           sync.RWMutex
 	lockRegistryKey        sync.RWMutex
 	lockReset              sync.RWMutex
@@ -383,10 +371,10 @@ type StatePluginMock struct {
 	lockSetCode            sync.RWMutex
 	lockSetNonce           sync.RWMutex
 	lockSetState           sync.RWMutex
-	lockSetStateOverride   sync.RWMutex
 	lockSetStorage         sync.RWMutex
 	lockSnapshot           sync.RWMutex
 	lockStateAtBlockNumber sync.RWMutex
+	lockStateRoot          sync.RWMutex
 	lockSubBalance         sync.RWMutex
 }
 
@@ -862,33 +850,6 @@ func (mock *StatePluginMock) GetNonceCalls() []struct {
 	return calls
 }
 
-// GetOverridenState calls GetOverridenStateFunc.
-func (mock *StatePluginMock) GetOverridenState() core.StatePlugin {
-	if mock.GetOverridenStateFunc == nil {
-		panic("StatePluginMock.GetOverridenStateFunc: method is nil but StatePlugin.GetOverridenState was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockGetOverridenState.Lock()
-	mock.calls.GetOverridenState = append(mock.calls.GetOverridenState, callInfo)
-	mock.lockGetOverridenState.Unlock()
-	return mock.GetOverridenStateFunc()
-}
-
-// GetOverridenStateCalls gets all the calls that were made to GetOverridenState.
-// Check the length with:
-//
-//	len(mockedStatePlugin.GetOverridenStateCalls())
-func (mock *StatePluginMock) GetOverridenStateCalls() []struct {
-} {
-	var calls []struct {
-	}
-	mock.lockGetOverridenState.RLock()
-	calls = mock.calls.GetOverridenState
-	mock.lockGetOverridenState.RUnlock()
-	return calls
-}
-
 // GetState calls GetStateFunc.
 func (mock *StatePluginMock) GetState(address common.Address, hash common.Hash) common.Hash {
 	if mock.GetStateFunc == nil {
@@ -1164,38 +1125,6 @@ func (mock *StatePluginMock) SetStateCalls() []struct {
 	return calls
 }
 
-// SetStateOverride calls SetStateOverrideFunc.
-func (mock *StatePluginMock) SetStateOverride(ctx context.Context) {
-	if mock.SetStateOverrideFunc == nil {
-		panic("StatePluginMock.SetStateOverrideFunc: method is nil but StatePlugin.SetStateOverride was just called")
-	}
-	callInfo := struct {
-		Ctx context.Context
-	}{
-		Ctx: ctx,
-	}
-	mock.lockSetStateOverride.Lock()
-	mock.calls.SetStateOverride = append(mock.calls.SetStateOverride, callInfo)
-	mock.lockSetStateOverride.Unlock()
-	mock.SetStateOverrideFunc(ctx)
-}
-
-// SetStateOverrideCalls gets all the calls that were made to SetStateOverride.
-// Check the length with:
-//
-//	len(mockedStatePlugin.SetStateOverrideCalls())
-func (mock *StatePluginMock) SetStateOverrideCalls() []struct {
-	Ctx context.Context
-} {
-	var calls []struct {
-		Ctx context.Context
-	}
-	mock.lockSetStateOverride.RLock()
-	calls = mock.calls.SetStateOverride
-	mock.lockSetStateOverride.RUnlock()
-	return calls
-}
-
 // SetStorage calls SetStorageFunc.
 func (mock *StatePluginMock) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
 	if mock.SetStorageFunc == nil {
@@ -1291,6 +1220,33 @@ func (mock *StatePluginMock) StateAtBlockNumberCalls() []struct {
 	return calls
 }
 
+// StateRoot calls StateRootFunc.
+func (mock *StatePluginMock) StateRoot() common.Hash {
+	if mock.StateRootFunc == nil {
+		panic("StatePluginMock.StateRootFunc: method is nil but StatePlugin.StateRoot was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStateRoot.Lock()
+	mock.calls.StateRoot = append(mock.calls.StateRoot, callInfo)
+	mock.lockStateRoot.Unlock()
+	return mock.StateRootFunc()
+}
+
+// StateRootCalls gets all the calls that were made to StateRoot.
+// Check the length with:
+//
+//	len(mockedStatePlugin.StateRootCalls())
+func (mock *StatePluginMock) StateRootCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStateRoot.RLock()
+	calls = mock.calls.StateRoot
+	mock.lockStateRoot.RUnlock()
+	return calls
+}
+
 // SubBalance calls SubBalanceFunc.
 func (mock *StatePluginMock) SubBalance(address common.Address, intMoqParam *big.Int) {
 	if mock.SubBalanceFunc == nil {
@@ -35,6 +35,8 @@ import (
 type Injector struct {
 	// precompiles stores the precompiles.
 	precompiles []Registrable
+	// dynamicKinds stores the constructors of the dynamic precompiles, indexed by their kind.
+	dynamicKinds map[string]DynamicConstructor
 }
 
 func NewPrecompiles(precompiles ...Registrable) *Injector {
@@ -53,12 +55,28 @@ func (pci *Injector) AddPrecompile(precompile Registrable) {
 	pci.precompiles = append(pci.precompiles, precompile)
 }
 
+// GetDynamicKinds returns the constructors of the dynamic precompiles, indexed by their kind.
+func (pci *Injector) GetDynamicKinds() map[string]DynamicConstructor {
+	return pci.dynamicKinds
+}
+
+// AddDynamicKind adds a kind of dynamic precompiles to the injector, which are instantiated at
+// runtime with the given constructor.
+func (pci *Injector) AddDynamicKind(kind string, constructor DynamicConstructor) {
+	if pci.dynamicKinds == nil {
+		pci.dynamicKinds = make(map[string]DynamicConstructor)
+	}
+	pci.dynamicKinds[kind] = constructor
+}
+
 // ==============================================================================
 // Base Precompile
 // ==============================================================================
 
 type BaseContract interface {
 	StatefulImpl
+	SpecialFunctionsImpl
+	ABIErrorsImpl
 	GetPlugin() Plugin
 }
 
@@ -95,6 +113,27 @@ func (c *baseContract) ABIEvents() map[string]abi.Event {
 	return c.abi.Events
 }
 
+// ABIErrors implements ABIErrorsImpl.
+func (c *baseContract) ABIErrors() map[string]abi.Error {
+	return c.abi.Errors
+}
+
+// ABIReceive implements SpecialFunctionsImpl.
+func (c *baseContract) ABIReceive() *abi.Method {
+	if !c.abi.HasReceive() {
+		return nil
+	}
+	return &c.abi.Receive
+}
+
+// ABIFallback implements SpecialFunctionsImpl.
+func (c *baseContract) ABIFallback() *abi.Method {
+	if !c.abi.HasFallback() {
+		return nil
+	}
+	return &c.abi.Fallback
+}
+
 // CustomValueDecoders implements StatefulImpl.
 func (c *baseContract) CustomValueDecoders() ValueDecoders {
 	return nil
@@ -46,4 +46,19 @@ var (
 	// corresponding ABI method.
 	ErrNoPrecompileMethodForABIMethod = errors.New(
 		"this ABI method does not have a corresponding precompile method")
+
+	// ErrNoMethodForGasSchedule is returned when a gas schedule is provided for a method that the
+	// precompile does not have.
+	ErrNoMethodForGasSchedule = errors.New(
+		"this gas schedule does not have a corresponding precompile method")
+
+	// ErrInvalidSpecialFunction is returned when the precompile method of an ABI `receive` or
+	// `fallback` function does not have the required signature.
+	ErrInvalidSpecialFunction = errors.New(
+		"the precompile method of this ABI special function has an invalid signature")
+
+	// ErrNonPayableMethod is returned when a precompile method, which is not payable, is called
+	// with value.
+	ErrNonPayableMethod = errors.New(
+		"the precompile method is not payable")
 )
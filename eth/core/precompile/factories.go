@@ -21,8 +21,10 @@
 package precompile
 
 import (
+	"context"
 	"reflect"
 
+	"github.com/berachain/polaris/eth/accounts/abi"
 	errorslib "github.com/berachain/polaris/lib/errors"
 	"github.com/berachain/polaris/lib/utils"
 
@@ -33,6 +35,19 @@ const (
 	// impl names stored as constants, to be used in error messages.
 	statelessContainerName = `StatelessImpl`
 	statefulContainerName  = `StatefulImpl`
+
+	// names of the ABI special functions, to be used in error messages.
+	receiveName  = `receive`
+	fallbackName = `fallback`
+)
+
+var (
+	// receiveType is the type of the precompile method of an ABI `receive` function, without
+	// the receiver contract.
+	receiveType = reflect.TypeOf((func(context.Context) error)(nil))
+	// fallbackType is the type of the precompile method of an ABI `fallback` function, without
+	// the receiver contract.
+	fallbackType = reflect.TypeOf((func(context.Context, []byte) ([]byte, error))(nil))
 )
 
 // AbstractFactory is an interface that all precompile container factories must adhere to.
@@ -100,13 +115,33 @@ func (sf *StatefulFactory) Build(
 	// attach the precompile plugin to the stateful contract
 	si.SetPlugin(p)
 
-	// add precompile methods to stateful container, if any exist
-	idsToMethods, err := buildIdsToMethods(si, reflect.ValueOf(si))
+	// add precompile methods to stateful container, if any exist, which are run by the generated
+	// dispatchers of the precompile if it provides them
+	var (
+		idsToMethods map[methodID]*method
+		err          error
+	)
+	if di, isDispatched := utils.GetAs[DispatcherImpl](si); isDispatched {
+		idsToMethods, err = buildIdsToDispatchers(si, di)
+	} else {
+		idsToMethods, err = buildIdsToMethods(si, reflect.ValueOf(si))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// add the precompile receive and fallback functions to the stateful container, if any exist
+	receive, fallback, err := buildSpecialFunctions(si, reflect.ValueOf(si))
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStatefulContainer(si, idsToMethods)
+	// attach the gas schedules to the precompile methods, if any exist
+	if err = applyGasSchedule(si, idsToMethods, receive, fallback); err != nil {
+		return nil, err
+	}
+
+	return NewStatefulContainer(si, idsToMethods, receive, fallback)
 }
 
 // This function matches each Go implementation of the precompile to the ABI's respective function.
@@ -140,3 +175,100 @@ func buildIdsToMethods(si StatefulImpl, contractImpl reflect.Value) (map[methodI
 
 	return idsToMethods, nil
 }
+
+// This function matches the generated dispatchers of the precompile to the ABI's respective
+// functions, without reflecting on the Go implementation of the precompile.
+func buildIdsToDispatchers(
+	si StatefulImpl, di DispatcherImpl,
+) (map[methodID]*method, error) {
+	dispatchers := di.Dispatchers()
+	idsToMethods := make(map[methodID]*method)
+	for name, abiMethod := range si.ABIMethods() {
+		dispatch, found := dispatchers[name]
+		if !found {
+			return nil, errorslib.Wrap(ErrNoPrecompileMethodForABIMethod, name)
+		}
+		idsToMethods[methodID(abiMethod.ID)] = newDispatchedMethod(si, abiMethod, dispatch)
+	}
+	return idsToMethods, nil
+}
+
+// This function matches the Go implementations of the precompile to the ABI's `receive` and
+// `fallback` functions, if the precompile declares them. It returns nil for each special function
+// that is not declared in the ABI.
+func buildSpecialFunctions(
+	si StatefulImpl, contractImpl reflect.Value,
+) (*method, *method, error) {
+	sfi, ok := utils.GetAs[SpecialFunctionsImpl](si)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	receive, err := buildSpecialFunction(
+		si, contractImpl.Type(), sfi.ABIReceive(), receiveName, receiveType,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	fallback, err := buildSpecialFunction(
+		si, contractImpl.Type(), sfi.ABIFallback(), fallbackName, fallbackType,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return receive, fallback, nil
+}
+
+// buildSpecialFunction finds the Go implementation of the given ABI special function, which is
+// the method named after the special function, and validates that it has the required signature.
+func buildSpecialFunction(
+	si StatefulImpl, contractImplType reflect.Type,
+	abiFunction *abi.Method, name string, implType reflect.Type,
+) (*method, error) {
+	if abiFunction == nil {
+		return nil, nil
+	}
+
+	implMethod, found := contractImplType.MethodByName(formatImplName(name))
+	if !found {
+		return nil, errorslib.Wrap(ErrNoPrecompileMethodForABIMethod, name)
+	}
+	if !matchesSignature(implMethod, implType) {
+		return nil, errorslib.Wrapf(
+			ErrInvalidSpecialFunction, "%s: expected %v, got %v", name, implType, implMethod.Type,
+		)
+	}
+
+	return newMethod(si, *abiFunction, implMethod), nil
+}
+
+// applyGasSchedule attaches the gas schedules declared by the precompile implementation to the
+// respective methods. It returns an error if a gas schedule does not match any method.
+func applyGasSchedule(
+	si StatefulImpl, idsToMethods map[methodID]*method, receive, fallback *method,
+) error {
+	gsi, ok := utils.GetAs[GasScheduleImpl](si)
+	if !ok {
+		return nil
+	}
+
+	methods := make(map[string]*method, len(idsToMethods)+2)
+	for _, m := range idsToMethods {
+		methods[m.name()] = m
+	}
+	if receive != nil {
+		methods[receiveName] = receive
+	}
+	if fallback != nil {
+		methods[fallbackName] = fallback
+	}
+
+	for name, gas := range gsi.GasSchedule() {
+		m, found := methods[name]
+		if !found {
+			return errorslib.Wrap(ErrNoMethodForGasSchedule, name)
+		}
+		m.gas = gas
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ package precompile
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 
 	solidity "github.com/berachain/polaris/contracts/bindings/testing"
@@ -94,6 +95,65 @@ var _ = Describe("Container Factories", func() {
 		})
 	})
 
+	Context("Stateful Container With Special Functions", func() {
+		var scf *StatefulFactory
+
+		BeforeEach(func() {
+			scf = NewStatefulFactory()
+		})
+
+		It("should build stateful containers with receive and fallback functions", func() {
+			pc, err := scf.Build(newMockPayable(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pc.(*statefulContainer).receive).ToNot(BeNil())
+			Expect(pc.(*statefulContainer).fallback).ToNot(BeNil())
+		})
+
+		It("should error on missing precompile method for ABI special function", func() {
+			_, err := scf.Build(&mockNoReceive{NewBaseContract(mockPayableABI, common.Address{})}, nil)
+			Expect(err).To(MatchError(
+				"this ABI method does not have a corresponding precompile method: receive"))
+		})
+
+		It("should error on gas schedules for unknown methods", func() {
+			mnr := &mockNoReceive{NewBaseContract(mockPayableABI, common.Address{})}
+			Expect(applyGasSchedule(mnr, map[methodID]*method{}, nil, nil)).To(MatchError(
+				"this gas schedule does not have a corresponding precompile method: unknown"))
+		})
+
+		It("should error on invalid precompile method for ABI special function", func() {
+			_, err := scf.Build(
+				&mockBadFallback{NewBaseContract(mockPayableABI, common.Address{})}, nil,
+			)
+			Expect(err).To(MatchError(ErrInvalidSpecialFunction))
+		})
+	})
+
+	Context("Stateful Container With Dispatchers", func() {
+		var scf *StatefulFactory
+
+		BeforeEach(func() {
+			scf = NewStatefulFactory()
+		})
+
+		It("should build stateful containers with the dispatchers of the precompile", func() {
+			pc, err := scf.Build(newMockDispatched(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			for _, m := range pc.(*statefulContainer).idsToMethods {
+				Expect(m.dispatch).ToNot(BeNil())
+			}
+			Expect(pc.(*statefulContainer).receive).ToNot(BeNil())
+		})
+
+		It("should error on missing dispatcher for ABI method", func() {
+			md := newMockDispatched()
+			delete(md.dispatchers, "withdraw")
+			_, err := scf.Build(md, nil)
+			Expect(err).To(MatchError(
+				"this ABI method does not have a corresponding precompile method: withdraw"))
+		})
+	})
+
 	Context("Overloaded Stateful Container", func() {
 		It("should construct a stateful container with overloaded methods", func() {
 			scf := NewStatefulFactory()
@@ -218,3 +278,133 @@ func (bms *badMockStateful) ABIMethods() map[string]abi.Method {
 		"getOutputPartial": mock.Methods["getOutputPartial"],
 	}
 }
+
+// ============================================================================.
+
+// mockPayableABI is the ABI of a precompile with both a receive and a fallback function.
+const mockPayableABI = `[
+	{"type":"function","name":"received","inputs":[],
+		"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"withdraw","inputs":[{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"error","name":"InsufficientFunds","inputs":[
+		{"name":"balance","type":"uint256"},{"name":"amount","type":"uint256"}]},
+	{"type":"receive","stateMutability":"payable"},
+	{"type":"fallback","stateMutability":"payable"}
+]`
+
+type mockPayable struct {
+	BaseContract
+	received *big.Int
+}
+
+func newMockPayable() *mockPayable {
+	return &mockPayable{
+		BaseContract: NewBaseContract(mockPayableABI, common.HexToAddress("0x4242")),
+		received:     new(big.Int),
+	}
+}
+
+func (mp *mockPayable) GasSchedule() GasSchedule {
+	return GasSchedule{
+		"received": {Base: 100},
+		"fallback": {Base: 10, Dynamic: func(input []byte) uint64 { return uint64(3 * len(input)) }},
+	}
+}
+
+func (mp *mockPayable) Received(_ context.Context) (*big.Int, error) {
+	return mp.received, nil
+}
+
+func (mp *mockPayable) Withdraw(_ context.Context, amount *big.Int) (bool, error) {
+	if amount.Cmp(mp.received) > 0 {
+		return false, fmt.Errorf(
+			"cannot withdraw: %w", NewCustomError("InsufficientFunds", mp.received, amount),
+		)
+	}
+	mp.received.Sub(mp.received, amount)
+	return true, nil
+}
+
+func (mp *mockPayable) Receive(ctx context.Context) error {
+	value := pvm.UnwrapPolarContext(ctx).MsgValue()
+	if value.Sign() == 0 {
+		return errors.New("no value received")
+	}
+	mp.received.Add(mp.received, value)
+	return nil
+}
+
+func (mp *mockPayable) Fallback(_ context.Context, input []byte) ([]byte, error) {
+	return append([]byte{0xff}, input...), nil
+}
+
+// ============================================================================.
+
+// mockDispatched is a mockPayable whose methods are run by hand-written dispatchers, in the same
+// way as the generated ones.
+type mockDispatched struct {
+	*mockPayable
+	dispatchers map[string]Dispatcher
+}
+
+func newMockDispatched() *mockDispatched {
+	md := &mockDispatched{mockPayable: newMockPayable()}
+	md.dispatchers = map[string]Dispatcher{
+		"received": func(ctx context.Context, _ []any) ([]any, error) {
+			out0, err := md.Received(ctx)
+			return []any{out0}, err
+		},
+		"withdraw": func(ctx context.Context, args []any) ([]any, error) {
+			out0, err := md.Withdraw(ctx, args[0].(*big.Int))
+			return []any{out0}, err
+		},
+	}
+	return md
+}
+
+func (md *mockDispatched) Dispatchers() map[string]Dispatcher {
+	return md.dispatchers
+}
+
+// ============================================================================.
+type mockNoReceive struct {
+	BaseContract
+}
+
+func (mnr *mockNoReceive) GasSchedule() GasSchedule {
+	return GasSchedule{"unknown": {Base: 1}}
+}
+
+func (mnr *mockNoReceive) Withdraw(_ context.Context, _ *big.Int) (bool, error) {
+	return true, nil
+}
+
+func (mnr *mockNoReceive) Received(_ context.Context) (*big.Int, error) {
+	return common.Big0, nil
+}
+
+func (mnr *mockNoReceive) Fallback(_ context.Context, input []byte) ([]byte, error) {
+	return input, nil
+}
+
+// ============================================================================.
+type mockBadFallback struct {
+	BaseContract
+}
+
+func (mbf *mockBadFallback) Withdraw(_ context.Context, _ *big.Int) (bool, error) {
+	return true, nil
+}
+
+func (mbf *mockBadFallback) Received(_ context.Context) (*big.Int, error) {
+	return common.Big0, nil
+}
+
+func (mbf *mockBadFallback) Receive(_ context.Context) error {
+	return nil
+}
+
+func (mbf *mockBadFallback) Fallback(_ context.Context) error {
+	return nil
+}
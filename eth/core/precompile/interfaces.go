@@ -21,6 +21,10 @@
 package precompile
 
 import (
+	"context"
+	"math"
+	"math/big"
+
 	"github.com/berachain/polaris/eth/accounts/abi"
 	libtypes "github.com/berachain/polaris/lib/types"
 
@@ -45,6 +49,24 @@ type (
 		// EVM.
 		DisableReentrancy(vm.PrecompileEVM)
 	}
+
+	// ScheduledPlugin is an optional interface for precompile plugins, which activate and
+	// deactivate their precompiles by activation schedules (see `Schedule`).
+	ScheduledPlugin interface {
+		Plugin
+		// AtBlock returns a view of the plugin, which only gets the precompiles that are active in
+		// the block with the given number and timestamp.
+		AtBlock(number *big.Int, time uint64) Plugin
+	}
+
+	// ContextualPlugin is an optional interface for precompile plugins, which also get the
+	// precompiles that are kept in the state of the host chain (see `DynamicImpl`).
+	ContextualPlugin interface {
+		Plugin
+		// WithContext returns a view of the plugin, which also gets the precompiles that are kept
+		// in the state of the given context.
+		WithContext(ctx context.Context) Plugin
+	}
 )
 
 type (
@@ -86,6 +108,93 @@ type (
 		SetPlugin(Plugin)
 	}
 
+	// SpecialFunctionsImpl is an optional interface for stateful precompiled contracts, which
+	// declare a Solidity-style `receive` or `fallback` function in their ABI. A `receive` function
+	// must be implemented as `Receive(context.Context) error` and is run for calls with no input
+	// (i.e. plain value transfers). A `fallback` function must be implemented as
+	// `Fallback(context.Context, []byte) ([]byte, error)` and is run with the raw input for calls
+	// that do not match any method ID.
+	SpecialFunctionsImpl interface {
+		// ABIReceive should return the Go-Ethereum abi `receive` function, or nil if the contract
+		// does not have one.
+		ABIReceive() *abi.Method
+
+		// ABIFallback should return the Go-Ethereum abi `fallback` function, or nil if the
+		// contract does not have one.
+		ABIFallback() *abi.Method
+	}
+
+	// DispatcherImpl is an optional interface for stateful precompiled contracts, whose methods
+	// are run by dispatchers generated from their ABI (see `contracts/cmd/precompilegen`), rather
+	// than matched to the ABI methods and called by reflection. As the dispatchers call the typed
+	// Go interface generated from the ABI, a method signature that does not match the ABI fails
+	// to compile.
+	DispatcherImpl interface {
+		// Dispatchers should return a map of ABI method names to the dispatchers of the methods.
+		Dispatchers() map[string]Dispatcher
+	}
+
+	// ABIErrorsImpl is an optional interface for stateful precompiled contracts, which return
+	// Solidity custom errors (see `CustomError`) from their methods.
+	ABIErrorsImpl interface {
+		// ABIErrors should return a map of Ethereum error names to Go-Ethereum abi `Error`
+		// structs. NOTE: this can be directly loaded from the `Errors` field of a Go-Ethereum ABI
+		// struct.
+		ABIErrors() map[string]abi.Error
+	}
+
+	// GasScheduleImpl is an optional interface for stateful precompiled contracts, which charge
+	// gas for running their methods, in addition to the gas consumed by the host chain.
+	GasScheduleImpl interface {
+		// GasSchedule should return a map of ABI method names (or `receive` and `fallback` for
+		// the special functions) to the gas schedules of the methods. Methods without a gas
+		// schedule do not charge any gas before they are run.
+		GasSchedule() GasSchedule
+	}
+
+	// ScheduledImpl is an optional interface for precompiled contracts, which are added or retired
+	// in an upgrade of the chain and thus are only active between the points of their schedule.
+	ScheduledImpl interface {
+		// ActivationSchedule should return the activation schedule of the contract.
+		ActivationSchedule() Schedule
+	}
+
+	// MethodGasContract is the interface for precompiled contracts, which charge the gas of the
+	// gas schedules of their methods.
+	MethodGasContract interface {
+		// MethodGas returns the name and the gas schedule of the method that is run for the given
+		// input, or false if no method is run for the input.
+		MethodGas(input []byte) (string, MethodGas, bool)
+
+		// HasMethod returns true iff the contract has a method with the given name.
+		HasMethod(name string) bool
+	}
+
+	// ReadOnlyContract is the interface for precompiled contracts, which declare the methods that
+	// must not modify the state (i.e. `view` and `pure` methods).
+	ReadOnlyContract interface {
+		// IsReadOnly returns true iff the method that is run for the given input must not modify
+		// the state.
+		IsReadOnly(input []byte) bool
+	}
+
+	// PayableContract is the interface for precompiled contracts, which declare the methods that
+	// accept value (i.e. `payable` methods).
+	PayableContract interface {
+		// IsPayable returns false iff the method that is run for the given input does not accept
+		// value.
+		IsPayable(input []byte) bool
+	}
+
+	// DecodingContract is the interface for precompiled contracts, which decode the method and the
+	// arguments of their inputs (e.g. for tracers).
+	DecodingContract interface {
+		// DecodeInput returns the name of the method that is run for the given input and the
+		// arguments unpacked from the input, or false if no method is run for the input. The
+		// arguments are nil if they cannot be unpacked, or if the method takes the raw input.
+		DecodeInput(input []byte) (string, []any, bool)
+	}
+
 	// DynamicImpl is the interface for all dynamic stateful precompiled contracts.
 	DynamicImpl interface {
 		StatefulImpl
@@ -93,6 +202,16 @@ type (
 		// Name should return a string name of the dynamic contract.
 		Name() string
 	}
+
+	// Dispatcher is a type of function that runs a stateful precompile method with the arguments
+	// unpacked from the call input, and returns the values to pack as the outputs of the method.
+	// NOTE: this is an alias, so that generated code does not need to import this package.
+	Dispatcher = func(ctx context.Context, args []any) ([]any, error)
+
+	// DynamicConstructor is a type of function that builds a dynamic stateful precompiled
+	// contract, which is deployed at the given address, from the arguments of its instantiation
+	// (e.g. a bank denom).
+	DynamicConstructor func(address common.Address, args string) (DynamicImpl, error)
 )
 
 type (
@@ -104,3 +223,28 @@ type (
 	// functions.
 	ValueDecoders map[string]ValueDecoder
 )
+
+type (
+	// MethodGas is the gas schedule of a stateful precompile method.
+	MethodGas struct {
+		// Base is the gas charged for every call to the method.
+		Base uint64
+		// Dynamic optionally returns the gas charged in addition to the base gas for the given
+		// call input, including the method ID.
+		Dynamic func(input []byte) uint64
+	}
+	// GasSchedule is a type that represents a map of method names to their gas schedules.
+	GasSchedule map[string]MethodGas
+)
+
+// RequiredGas returns the gas charged for a call to the method with the given input.
+func (mg MethodGas) RequiredGas(input []byte) uint64 {
+	if mg.Dynamic == nil {
+		return mg.Base
+	}
+	dynamic := mg.Dynamic(input)
+	if mg.Base > math.MaxUint64-dynamic {
+		return math.MaxUint64
+	}
+	return mg.Base + dynamic
+}
@@ -62,6 +62,13 @@ type method struct {
 	// execute is the precompile's executable which will execute the logic of the implemented
 	// ABI method.
 	execute reflect.Method
+
+	// dispatch is the generated dispatcher of the method, which is called instead of the
+	// executable if set.
+	dispatch Dispatcher
+
+	// gas is the gas schedule of the method, which is charged before the method is run.
+	gas MethodGas
 }
 
 // newMethod creates and returns a new `method` with the given abiMethod, abiSig, and executable.
@@ -75,6 +82,16 @@ func newMethod(
 	}
 }
 
+// newDispatchedMethod creates and returns a new `method` with the given abiMethod and generated
+// dispatcher.
+func newDispatchedMethod(rcvr StatefulImpl, abiMethod abi.Method, dispatch Dispatcher) *method {
+	return &method{
+		rcvr:      rcvr,
+		abiMethod: abiMethod,
+		dispatch:  dispatch,
+	}
+}
+
 // Call executes the precompile's executable with the given context and input arguments.
 func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 	// Unpack the args from the input, if any exist.
@@ -83,6 +100,15 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	// Call the generated dispatcher with the unpacked args, if any.
+	if m.dispatch != nil {
+		retVals, dispatchErr := m.dispatch(ctx, unpackedArgs)
+		if revert, revertErr := m.revert(dispatchErr); revertErr != nil {
+			return revert, revertErr
+		}
+		return m.abiMethod.Outputs.PackValues(retVals)
+	}
+
 	// Convert the unpacked args to reflect values.
 	reflectedUnpackedArgs := make([]reflect.Value, 0, len(unpackedArgs))
 	for _, unpacked := range unpackedArgs {
@@ -102,19 +128,10 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 		),
 	)
 
-	// If the precompile returned an error, the error is returned to the caller.
-	if revert := results[len(results)-1].Interface(); revert != nil {
-		err = utils.MustGetAs[error](revert)
-	}
-	if err != nil {
-		if !errors.Is(err, vm.ErrWriteProtection) {
-			err = errorslib.Wrapf(
-				vm.ErrExecutionReverted,
-				"vm error [%v] occurred during precompile execution of [%s]",
-				err, m.abiMethod.Name,
-			)
-		}
-		return nil, err
+	// If the precompile returned an error, the error is returned to the caller along with the
+	// revert data.
+	if revert, revertErr := m.revert(resultErr(results)); revertErr != nil {
+		return revert, revertErr
 	}
 
 	// Pack the return values and return, if any exist.
@@ -129,3 +146,60 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 
 	return ret, nil
 }
+
+// CallWithRawInput executes the precompile's `receive` or `fallback` executable with the given
+// context. The raw input is passed as is to a `fallback` executable.
+func (m *method) CallWithRawInput(ctx context.Context, input []byte) ([]byte, error) {
+	args := []reflect.Value{reflect.ValueOf(m.rcvr), reflect.ValueOf(ctx)}
+	if m.abiMethod.Type == abi.Fallback {
+		args = append(args, reflect.ValueOf(input))
+	}
+
+	// Call the executable, a `receive` executable only returns an error.
+	results := m.execute.Func.Call(args)
+	if revert, err := m.revert(resultErr(results)); err != nil {
+		return revert, err
+	}
+	if len(results) == 1 {
+		return nil, nil
+	}
+	return results[0].Bytes(), nil
+}
+
+// resultErr returns the error returned by the precompile's executable, which is its last result.
+func resultErr(results []reflect.Value) error {
+	revert := results[len(results)-1].Interface()
+	if revert == nil {
+		return nil
+	}
+	return utils.MustGetAs[error](revert)
+}
+
+// revert returns the error returned by the precompile's executable, if any, as an EVM revert
+// with the ABI encoded revert data of the error.
+func (m *method) revert(err error) ([]byte, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	if errors.Is(err, vm.ErrWriteProtection) {
+		return nil, err
+	}
+	return revertData(m.rcvr, err), errorslib.Wrapf(
+		vm.ErrExecutionReverted,
+		"vm error [%v] occurred during precompile execution of [%s]",
+		err, m.name(),
+	)
+}
+
+// name returns the name of the ABI method, or `receive` and `fallback` for the special functions.
+func (m *method) name() string {
+	switch m.abiMethod.Type { //nolint:exhaustive // only special functions are unnamed.
+	case abi.Receive:
+		return receiveName
+	case abi.Fallback:
+		return fallbackName
+	default:
+		return m.abiMethod.Name
+	}
+}
@@ -104,3 +104,35 @@ func formatName(name string) string {
 
 	return string(ret)
 }
+
+// matchesSignature returns true iff the Go implementation method, excluding its receiver contract,
+// has exactly the given function type.
+func matchesSignature(implMethod reflect.Method, funcType reflect.Type) bool {
+	if implMethod.Type.NumIn()-1 != funcType.NumIn() ||
+		implMethod.Type.NumOut() != funcType.NumOut() {
+		return false
+	}
+	for i := 0; i < funcType.NumIn(); i++ {
+		if implMethod.Type.In(i+1) != funcType.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < funcType.NumOut(); i++ {
+		if implMethod.Type.Out(i) != funcType.Out(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatImplName converts the first character of name to uppercase.
+func formatImplName(name string) string {
+	if len(name) == 0 {
+		return name
+	}
+
+	ret := []rune(name)
+	ret[0] = unicode.ToUpper(ret[0])
+
+	return string(ret)
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+	"github.com/berachain/polaris/lib/utils"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// revertSelector is the selector of the Solidity `Error(string)` error, which encodes the
+	// revert reason of the errors that are not custom errors.
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:NumBytesMethodID]
+	// revertReasonArgs are the arguments of the Solidity `Error(string)` error.
+	revertReasonArgs = func() abi.Arguments {
+		stringType, _ := abi.NewType("string", "", nil)
+		return abi.Arguments{{Type: stringType}}
+	}()
+)
+
+// CustomError is a Solidity custom error returned by a stateful precompile method. The error is
+// returned to the caller as the revert data of the ABI error with the same name, so that it can be
+// decoded (or caught in a Solidity `try/catch`) by the caller.
+type CustomError struct {
+	// Name is the name of the ABI error.
+	Name string
+	// Args are the arguments of the ABI error.
+	Args []any
+}
+
+// NewCustomError creates and returns a new `CustomError` with the given ABI error name and args.
+func NewCustomError(name string, args ...any) *CustomError {
+	return &CustomError{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Error implements error.
+func (ce *CustomError) Error() string {
+	return fmt.Sprintf("%s%v", ce.Name, ce.Args)
+}
+
+// revertData returns the revert data of the given error returned by a method of the given
+// precompile. Custom errors, which are declared in the ABI of the precompile, are packed as the
+// ABI error. Any other error is packed as `Error(string)` with the error message as the reason.
+func revertData(rcvr StatefulImpl, err error) []byte {
+	var ce *CustomError
+	if errors.As(err, &ce) {
+		if abiErrs, ok := utils.GetAs[ABIErrorsImpl](rcvr); ok {
+			if abiErr, found := abiErrs.ABIErrors()[ce.Name]; found {
+				if args, packErr := abiErr.Inputs.Pack(ce.Args...); packErr == nil {
+					return append(append([]byte{}, abiErr.ID[:NumBytesMethodID]...), args...)
+				}
+			}
+		}
+	}
+
+	reason, packErr := revertReasonArgs.Pack(err.Error())
+	if packErr != nil {
+		return nil
+	}
+	return append(append([]byte{}, revertSelector...), reason...)
+}
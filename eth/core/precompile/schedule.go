@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import "math/big"
+
+// ActivationPoint is a point of the chain, which is reached at the block with the given number or
+// at the first block with a timestamp of at least the given time. Only one of them should be set.
+type ActivationPoint struct {
+	// Block is the number of the block at which the point is reached.
+	Block *big.Int
+	// Time is the timestamp of the block at which the point is reached.
+	Time *uint64
+}
+
+// BlockActivation returns the point that is reached at the block with the given number.
+func BlockActivation(number uint64) *ActivationPoint {
+	return &ActivationPoint{Block: new(big.Int).SetUint64(number)}
+}
+
+// TimeActivation returns the point that is reached at the first block with the given timestamp.
+func TimeActivation(time uint64) *ActivationPoint {
+	return &ActivationPoint{Time: &time}
+}
+
+// ReachedAt returns whether the point is reached by the block with the given number and timestamp.
+func (ap *ActivationPoint) ReachedAt(number *big.Int, time uint64) bool {
+	switch {
+	case ap.Block != nil:
+		return number != nil && ap.Block.Cmp(number) <= 0
+	case ap.Time != nil:
+		return *ap.Time <= time
+	default:
+		return true
+	}
+}
+
+// Schedule is the activation schedule of a precompile, so that adding or retiring a precompile in
+// an upgrade of the chain does not change the execution of the blocks before the upgrade. A nil
+// Activation means the precompile is active since genesis and a nil Deactivation means the
+// precompile is never retired.
+type Schedule struct {
+	// Activation is the point from which on the precompile is active.
+	Activation *ActivationPoint
+	// Deactivation is the point from which on the precompile is no longer active.
+	Deactivation *ActivationPoint
+}
+
+// IsActive returns whether the precompile is active in the block with the given number and
+// timestamp.
+func (s Schedule) IsActive(number *big.Int, time uint64) bool {
+	if s.Activation != nil && !s.Activation.ReachedAt(number, time) {
+		return false
+	}
+	return s.Deactivation == nil || !s.Deactivation.ReachedAt(number, time)
+}
+
+// Upgrades are the blocks from which on the stateful precompiles change their behaviour, so that
+// the blocks before an upgrade are replayed with the behaviour they were executed with. A nil
+// block means the upgrade is never activated, and a zero block means it is active since genesis.
+type Upgrades struct {
+	// GasSchedulesBlock is the block from which on the gas schedules of the methods are charged.
+	GasSchedulesBlock *big.Int
+	// NonPayableBlock is the block from which on the calls with value to methods that are not
+	// payable are reverted.
+	NonPayableBlock *big.Int
+}
+
+// IsGasSchedules returns whether the gas schedules of the methods are charged in the block with
+// the given number.
+func (u Upgrades) IsGasSchedules(number *big.Int) bool {
+	return isBlockUpgraded(u.GasSchedulesBlock, number)
+}
+
+// IsNonPayable returns whether the calls with value to methods that are not payable are reverted
+// in the block with the given number.
+func (u Upgrades) IsNonPayable(number *big.Int) bool {
+	return isBlockUpgraded(u.NonPayableBlock, number)
+}
+
+// isBlockUpgraded returns whether the upgrade at the given block is active in the block with the
+// given number.
+func isBlockUpgraded(upgrade, number *big.Int) bool {
+	return upgrade != nil && number != nil && upgrade.Cmp(number) <= 0
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	It("should be active since genesis without an activation point", func() {
+		s := Schedule{}
+		Expect(s.IsActive(big.NewInt(0), 0)).To(BeTrue())
+		Expect(s.IsActive(big.NewInt(100), 100)).To(BeTrue())
+	})
+
+	It("should be active between the activation and deactivation blocks", func() {
+		s := Schedule{Activation: BlockActivation(10), Deactivation: BlockActivation(20)}
+		Expect(s.IsActive(big.NewInt(9), 0)).To(BeFalse())
+		Expect(s.IsActive(big.NewInt(10), 0)).To(BeTrue())
+		Expect(s.IsActive(big.NewInt(19), 0)).To(BeTrue())
+		Expect(s.IsActive(big.NewInt(20), 0)).To(BeFalse())
+		Expect(s.IsActive(nil, 0)).To(BeFalse())
+	})
+
+	It("should be active from the activation timestamp", func() {
+		s := Schedule{Activation: TimeActivation(1000)}
+		Expect(s.IsActive(big.NewInt(50), 999)).To(BeFalse())
+		Expect(s.IsActive(big.NewInt(50), 1000)).To(BeTrue())
+		Expect(s.IsActive(big.NewInt(50), 5000)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Upgrades", func() {
+	It("should never be active without an upgrade block", func() {
+		u := Upgrades{}
+		Expect(u.IsGasSchedules(big.NewInt(0))).To(BeFalse())
+		Expect(u.IsGasSchedules(big.NewInt(100))).To(BeFalse())
+		Expect(u.IsNonPayable(big.NewInt(100))).To(BeFalse())
+	})
+
+	It("should be active from the upgrade block", func() {
+		u := Upgrades{GasSchedulesBlock: big.NewInt(10)}
+		Expect(u.IsGasSchedules(big.NewInt(9))).To(BeFalse())
+		Expect(u.IsGasSchedules(big.NewInt(10))).To(BeTrue())
+		Expect(u.IsGasSchedules(nil)).To(BeFalse())
+		Expect(u.IsNonPayable(big.NewInt(10))).To(BeFalse())
+	})
+})
@@ -33,7 +33,12 @@ import (
 // NumBytesMethodID is the number of bytes used to represent a ABI method's ID.
 const NumBytesMethodID = 4
 
-var _ vm.PrecompiledContract = (*statefulContainer)(nil)
+var (
+	_ vm.PrecompiledContract = (*statefulContainer)(nil)
+	_ MethodGasContract      = (*statefulContainer)(nil)
+	_ ReadOnlyContract       = (*statefulContainer)(nil)
+	_ PayableContract        = (*statefulContainer)(nil)
+)
 
 // statefulContainer is a container for running statefulContainer and precompiled contracts.
 type statefulContainer struct {
@@ -44,15 +49,18 @@ type statefulContainer struct {
 	// precompile creator and must exactly match the signature in the geth abi.Method.Sig field
 	// (geth abi format). Please check core/precompile/container/method.go for more information.
 	idsToMethods map[methodID]*method
-	// receive      *Method // TODO: implement
-	// fallback     *Method // TODO: implement
-
+	// receive is the precompile function run for calls with no input, nil if the precompile
+	// does not declare a `receive` function.
+	receive *method
+	// fallback is the precompile function run for calls that do not match any method, nil if
+	// the precompile does not declare a `fallback` function.
+	fallback *method
 }
 
 // NewStatefulContainer creates and returns a new `statefulContainer` with the given method ids
-// precompile functions map.
+// precompile functions map and the optional receive and fallback precompile functions.
 func NewStatefulContainer(
-	si StatefulImpl, idsToMethods map[methodID]*method,
+	si StatefulImpl, idsToMethods map[methodID]*method, receive, fallback *method,
 ) (vm.PrecompiledContract, error) {
 	if idsToMethods == nil {
 		return nil, ErrContainerHasNoMethods
@@ -60,6 +68,8 @@ func NewStatefulContainer(
 	return &statefulContainer{
 		StatefulImpl: si,
 		idsToMethods: idsToMethods,
+		receive:      receive,
+		fallback:     fallback,
 	}, nil
 }
 
@@ -74,27 +84,111 @@ func (sc *statefulContainer) Run(
 	caller common.Address,
 	value *big.Int,
 ) ([]byte, error) {
-	if len(input) < NumBytesMethodID {
+	// Load the method for the input, which is either the method of the input's method ID, or one
+	// of the receive and fallback functions.
+	method := sc.methodFor(input)
+	switch {
+	case method == nil && len(input) < NumBytesMethodID:
 		return nil, ErrInvalidInputToPrecompile
+	case method == nil:
+		return nil, ErrMethodNotFound
 	}
 
-	// Extract the method ID from the input and load the method.
-	method, found := sc.idsToMethods[methodID(input)]
-	if !found {
-		return nil, ErrMethodNotFound
+	polarCtx := pvm.NewPolarContext(ctx, evm, caller, value)
+	if method == sc.receive || method == sc.fallback {
+		return method.CallWithRawInput(polarCtx, input)
 	}
 
 	// Execute the method with the reflected ctx and raw input
-	return method.Call(
-		pvm.NewPolarContext(ctx, evm, caller, value),
-		input,
-	)
+	return method.Call(polarCtx, input)
 }
 
-// RequiredGas checks the Method corresponding to input for the required gas amount. TODO: remove
-// unneeded input from interface.
+// RequiredGas returns the gas of the gas schedule of the method corresponding to input. It returns
+// 0 if no method corresponds to input, as running the input fails.
 //
 // RequiredGas implements PrecompileContainer.
-func (sc *statefulContainer) RequiredGas([]byte) uint64 {
-	return 0
+func (sc *statefulContainer) RequiredGas(input []byte) uint64 {
+	_, gas, _ := sc.MethodGas(input)
+	return gas.RequiredGas(input)
+}
+
+// MethodGas returns the name and the gas schedule of the method, which is run for the given input
+// with the same dispatch as `Run`.
+//
+// MethodGas implements MethodGasContract.
+func (sc *statefulContainer) MethodGas(input []byte) (string, MethodGas, bool) {
+	if m := sc.methodFor(input); m != nil {
+		return m.name(), m.gas, true
+	}
+	return "", MethodGas{}, false
+}
+
+// HasMethod implements MethodGasContract.
+func (sc *statefulContainer) HasMethod(name string) bool {
+	switch name {
+	case receiveName:
+		return sc.receive != nil
+	case fallbackName:
+		return sc.fallback != nil
+	}
+	for _, m := range sc.idsToMethods {
+		if m.name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReadOnly returns true iff the method, which is run for the given input, is a `view` or `pure`
+// method.
+//
+// IsReadOnly implements ReadOnlyContract.
+func (sc *statefulContainer) IsReadOnly(input []byte) bool {
+	m := sc.methodFor(input)
+	return m != nil && m.abiMethod.IsConstant()
+}
+
+// DecodeInput returns the name of the method, which is run for the given input, and the arguments
+// unpacked from the input. The receive and fallback functions take the raw input, so their
+// arguments are not unpacked.
+//
+// DecodeInput implements DecodingContract.
+func (sc *statefulContainer) DecodeInput(input []byte) (string, []any, bool) {
+	m := sc.methodFor(input)
+	switch {
+	case m == nil:
+		return "", nil, false
+	case m == sc.receive || m == sc.fallback:
+		return m.name(), nil, true
+	}
+	args, err := m.abiMethod.Inputs.Unpack(input[NumBytesMethodID:])
+	if err != nil {
+		return m.name(), nil, true
+	}
+	return m.name(), args, true
+}
+
+// IsPayable returns false iff the method, which is run for the given input, is not a `payable`
+// method.
+//
+// IsPayable implements PayableContract.
+func (sc *statefulContainer) IsPayable(input []byte) bool {
+	m := sc.methodFor(input)
+	return m == nil || m.abiMethod.IsPayable()
+}
+
+// methodFor returns the method that is run for the given input, or nil if there is none. Calls
+// with no input are plain value transfers, which are run by the receive function. As in Solidity,
+// the fallback function runs them if there is no receive function, as well as the calls that do
+// not match any method ID.
+func (sc *statefulContainer) methodFor(input []byte) *method {
+	if len(input) == 0 && sc.receive != nil {
+		return sc.receive
+	}
+	if len(input) >= NumBytesMethodID {
+		if m, found := sc.idsToMethods[methodID(input)]; found {
+			return m
+		}
+	}
+	return sc.fallback
 }
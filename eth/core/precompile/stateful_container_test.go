@@ -22,10 +22,12 @@ package precompile
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"reflect"
 
 	solidity "github.com/berachain/polaris/contracts/bindings/testing"
+	"github.com/berachain/polaris/eth/accounts/abi"
 	pvm "github.com/berachain/polaris/eth/core/vm"
 	vmmock "github.com/berachain/polaris/eth/core/vm/mock"
 
@@ -45,9 +47,11 @@ var _ = Describe("Stateful Container", func() {
 	var ctx context.Context
 
 	BeforeEach(func() {
-		sc, err = NewStatefulContainer(&mockStateful{&mockBase{}}, mockIdsToMethods)
+		sc, err = NewStatefulContainer(
+			&mockStateful{&mockBase{}}, mockIdsToMethods, nil, nil,
+		)
 		Expect(err).ToNot(HaveOccurred())
-		empty, err = NewStatefulContainer(nil, nil)
+		empty, err = NewStatefulContainer(nil, nil, nil, nil)
 		Expect(empty).To(BeNil())
 		Expect(err).To(MatchError("the stateful precompile has no methods to run"))
 		ctx = pvm.NewPolarContext(
@@ -143,6 +147,191 @@ var _ = Describe("Stateful Container", func() {
 	})
 })
 
+var _ = Describe("Stateful Container With Special Functions", func() {
+	var sc vm.PrecompiledContract
+	var mp *mockPayable
+	var evm vm.PrecompileEVM
+	var err error
+
+	BeforeEach(func() {
+		mp = newMockPayable()
+		sc, err = NewStatefulFactory().Build(mp, nil)
+		Expect(err).ToNot(HaveOccurred())
+		evm = vmmock.NewEVM()
+	})
+
+	It("should run the receive function for calls with no input", func() {
+		var ret []byte
+		ret, err = sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(7))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(BeEmpty())
+		Expect(mp.received).To(Equal(big.NewInt(7)))
+
+		_, err = sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(0))
+		Expect(err.Error()).To(Equal(
+			"execution reverted: vm error [no value received] occurred during precompile " +
+				"execution of [receive]",
+		))
+	})
+
+	It("should run the fallback function for short inputs and unknown method IDs", func() {
+		var ret []byte
+		ret, err = sc.Run(context.Background(), evm, []byte{1, 2}, common.Address{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(Equal([]byte{0xff, 1, 2}))
+
+		ret, err = sc.Run(context.Background(), evm, []byte{1, 2, 3, 4, 5}, common.Address{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(Equal([]byte{0xff, 1, 2, 3, 4, 5}))
+	})
+
+	It("should run the fallback function for calls with no input without receive", func() {
+		sc, err = NewStatefulContainer(mp, map[methodID]*method{}, nil, sc.(*statefulContainer).fallback)
+		Expect(err).ToNot(HaveOccurred())
+		var ret []byte
+		ret, err = sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(Equal([]byte{0xff}))
+	})
+
+	It("should charge the gas schedules of the methods", func() {
+		Expect(sc.RequiredGas(mp.ABIMethods()["received"].ID)).To(Equal(uint64(100)))
+		Expect(sc.RequiredGas([]byte{1, 2, 3, 4, 5})).To(Equal(uint64(25)))
+		// the receive function has no gas schedule
+		Expect(sc.RequiredGas(nil)).To(Equal(uint64(0)))
+
+		name, gas, found := sc.(MethodGasContract).MethodGas([]byte{1})
+		Expect(found).To(BeTrue())
+		Expect(name).To(Equal("fallback"))
+		Expect(gas.Base).To(Equal(uint64(10)))
+		Expect(sc.(MethodGasContract).HasMethod("received")).To(BeTrue())
+		Expect(sc.(MethodGasContract).HasMethod("receive")).To(BeTrue())
+		Expect(sc.(MethodGasContract).HasMethod("getOutput")).To(BeFalse())
+	})
+
+	It("should declare the methods that are not payable", func() {
+		Expect(sc.(PayableContract).IsPayable(mp.ABIMethods()["withdraw"].ID)).To(BeFalse())
+		Expect(sc.(PayableContract).IsPayable(mp.ABIMethods()["received"].ID)).To(BeFalse())
+		Expect(sc.(PayableContract).IsPayable(nil)).To(BeTrue())
+		Expect(sc.(PayableContract).IsPayable([]byte{1})).To(BeTrue())
+	})
+
+	It("should declare view methods as read-only", func() {
+		Expect(sc.(ReadOnlyContract).IsReadOnly(mp.ABIMethods()["received"].ID)).To(BeTrue())
+		Expect(sc.(ReadOnlyContract).IsReadOnly(mp.ABIMethods()["withdraw"].ID)).To(BeFalse())
+		Expect(sc.(ReadOnlyContract).IsReadOnly(nil)).To(BeFalse())
+	})
+
+	It("should decode the method and arguments of inputs", func() {
+		withdraw := mp.ABIMethods()["withdraw"]
+		args, err := withdraw.Inputs.Pack(big.NewInt(5))
+		Expect(err).ToNot(HaveOccurred())
+
+		name, unpacked, found := sc.(DecodingContract).DecodeInput(append(withdraw.ID, args...))
+		Expect(found).To(BeTrue())
+		Expect(name).To(Equal("withdraw"))
+		Expect(unpacked).To(Equal([]any{big.NewInt(5)}))
+
+		name, unpacked, found = sc.(DecodingContract).DecodeInput(withdraw.ID)
+		Expect(found).To(BeTrue())
+		Expect(name).To(Equal("withdraw"))
+		Expect(unpacked).To(BeNil())
+
+		name, unpacked, found = sc.(DecodingContract).DecodeInput([]byte{1, 2})
+		Expect(found).To(BeTrue())
+		Expect(name).To(Equal("fallback"))
+		Expect(unpacked).To(BeNil())
+	})
+
+	It("should return the revert data of custom errors", func() {
+		withdraw := mp.ABIMethods()["withdraw"]
+		args, err := withdraw.Inputs.Pack(big.NewInt(5))
+		Expect(err).ToNot(HaveOccurred())
+
+		var ret []byte
+		ret, err = sc.Run(
+			context.Background(), evm, append(withdraw.ID, args...), common.Address{}, nil,
+		)
+		Expect(errors.Is(err, vm.ErrExecutionReverted)).To(BeTrue())
+		abiErr := mp.ABIErrors()["InsufficientFunds"]
+		Expect(ret[:NumBytesMethodID]).To(Equal(abiErr.ID[:NumBytesMethodID]))
+		var unpacked any
+		unpacked, err = abiErr.Unpack(ret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unpacked).To(HaveLen(2))
+		Expect(unpacked.([]any)[0].(*big.Int).Sign()).To(Equal(0))
+		Expect(unpacked.([]any)[1].(*big.Int).Int64()).To(Equal(int64(5)))
+	})
+
+	It("should return the revert reason of other errors", func() {
+		ret, err := sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(0))
+		Expect(errors.Is(err, vm.ErrExecutionReverted)).To(BeTrue())
+		reason, err := abi.UnpackRevert(ret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reason).To(Equal("no value received"))
+	})
+
+	It("should still run the matching methods", func() {
+		_, err = sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(3))
+		Expect(err).ToNot(HaveOccurred())
+		var ret []byte
+		ret, err = sc.Run(
+			context.Background(), evm, mp.ABIMethods()["received"].ID, common.Address{}, nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(new(big.Int).SetBytes(ret)).To(Equal(big.NewInt(3)))
+	})
+})
+
+var _ = Describe("Stateful Container With Dispatchers", func() {
+	var sc vm.PrecompiledContract
+	var md *mockDispatched
+	var evm vm.PrecompileEVM
+	var err error
+
+	BeforeEach(func() {
+		md = newMockDispatched()
+		sc, err = NewStatefulFactory().Build(md, nil)
+		Expect(err).ToNot(HaveOccurred())
+		evm = vmmock.NewEVM()
+	})
+
+	It("should run the methods with their dispatchers", func() {
+		_, err = sc.Run(context.Background(), evm, nil, common.Address{}, big.NewInt(3))
+		Expect(err).ToNot(HaveOccurred())
+
+		withdraw := md.ABIMethods()["withdraw"]
+		var args, ret []byte
+		args, err = withdraw.Inputs.Pack(big.NewInt(2))
+		Expect(err).ToNot(HaveOccurred())
+		ret, err = sc.Run(
+			context.Background(), evm, append(withdraw.ID, args...), common.Address{}, nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(Equal(common.LeftPadBytes([]byte{1}, 32)))
+
+		ret, err = sc.Run(
+			context.Background(), evm, md.ABIMethods()["received"].ID, common.Address{}, nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(new(big.Int).SetBytes(ret)).To(Equal(big.NewInt(1)))
+	})
+
+	It("should return the revert data of errors of the dispatchers", func() {
+		withdraw := md.ABIMethods()["withdraw"]
+		args, err := withdraw.Inputs.Pack(big.NewInt(5))
+		Expect(err).ToNot(HaveOccurred())
+
+		var ret []byte
+		ret, err = sc.Run(
+			context.Background(), evm, append(withdraw.ID, args...), common.Address{}, nil,
+		)
+		Expect(errors.Is(err, vm.ErrExecutionReverted)).To(BeTrue())
+		abiErr := md.ABIErrors()["InsufficientFunds"]
+		Expect(ret[:NumBytesMethodID]).To(Equal(abiErr.ID[:NumBytesMethodID]))
+	})
+})
+
 // MOCKS BELOW.
 
 var (
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/berachain/polaris/lib/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DumpToCollector implements `StateDB` by streaming the accounts of the state plugin, in
+// ascending order of address, to the given collector. The `Start` of the config is the address
+// to start the dump at and the returned key is the address of the next account if the dump was
+// cut short by `Max`. It returns nil if the state plugin is not iterable.
+//
+// NOTE: the root of the state is not reported to the collector, as the state plugin would have
+// to commit to the whole state to compute it. Callers report the root of the header of the
+// dumped state instead.
+func (sdb *stateDB) DumpToCollector(c state.DumpCollector, conf *state.DumpConfig) []byte {
+	if conf == nil {
+		conf = new(state.DumpConfig)
+	}
+	ip, ok := utils.GetAs[IterablePlugin](sdb.Plugin)
+	if !ok {
+		log.Error("state dump is not supported by the state plugin")
+		return nil
+	}
+	var start common.Address
+	copy(start[:], conf.Start)
+
+	// Every source of accounts is iterated in ascending order of address from the start, so the
+	// first `Max` accounts of the state are among the first `Max` accounts of every source. One
+	// more account is collected from every source to know where the next dump starts.
+	seen := make(map[common.Address]struct{})
+	collect := func() func(common.Address) bool {
+		var (
+			prev  *common.Address
+			count uint64
+		)
+		return func(addr common.Address) bool {
+			if prev == nil || *prev != addr {
+				prev = &addr
+				count++
+				seen[addr] = struct{}{}
+			}
+			return conf.Max > 0 && count > conf.Max
+		}
+	}
+	nonces, balances, codes, storage := collect(), collect(), collect(), collect()
+	ip.IterateNonces(start, func(addr common.Address, _ uint64) bool {
+		return nonces(addr)
+	})
+	ip.IterateBalances(start, func(addr common.Address, _ *big.Int) bool {
+		return balances(addr)
+	})
+	ip.IterateCode(start, func(addr common.Address, _ common.Hash) bool {
+		return codes(addr)
+	})
+	ip.IterateState(start, func(addr common.Address, _, _ common.Hash) bool {
+		return storage(addr)
+	})
+
+	addresses := make([]common.Address, 0, len(seen))
+	for addr := range seen {
+		addresses = append(addresses, addr)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+	var next []byte
+	if conf.Max > 0 && uint64(len(addresses)) > conf.Max {
+		next = addresses[conf.Max].Bytes()
+		addresses = addresses[:conf.Max]
+	}
+	if len(addresses) == 0 {
+		return next
+	}
+
+	accounts := make(map[common.Address]*state.DumpAccount, len(addresses))
+	for _, addr := range addresses {
+		accounts[addr] = sdb.dumpAccount(addr, conf)
+	}
+
+	// Storage slots are loaded in a single pass over the state of the dumped accounts.
+	if !conf.SkipStorage {
+		last := addresses[len(addresses)-1]
+		ip.IterateState(addresses[0], func(addr common.Address, key, value common.Hash) bool {
+			if account, found := accounts[addr]; found {
+				account.Storage[key] = common.Bytes2Hex(common.TrimLeftZeroes(value[:]))
+			}
+			return addr.Cmp(last) > 0
+		})
+	}
+
+	for _, addr := range addresses {
+		account := accounts[addr]
+		c.OnAccount(account.Address, *account)
+	}
+	return next
+}
+
+// dumpAccount returns the dump of the given account, without its storage slots.
+func (sdb *stateDB) dumpAccount(addr common.Address, conf *state.DumpConfig) *state.DumpAccount {
+	root := ethtypes.EmptyRootHash
+	if pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin); ok {
+		root = pp.GetStorageRoot(addr)
+	}
+	address := addr
+	account := &state.DumpAccount{
+		Balance:     sdb.GetBalance(addr).String(),
+		Nonce:       sdb.GetNonce(addr),
+		Root:        root.Bytes(),
+		CodeHash:    sdb.GetCodeHash(addr).Bytes(),
+		Address:     &address,
+		AddressHash: crypto.Keccak256(addr.Bytes()),
+	}
+	if !conf.SkipCode {
+		account.Code = sdb.Plugin.GetCode(addr)
+	}
+	if !conf.SkipStorage {
+		account.Storage = make(map[common.Hash]string)
+	}
+	return account
+}
+
+// RawDump implements `StateDB` by returning the dump of the accounts of the state plugin.
+func (sdb *stateDB) RawDump(conf *state.DumpConfig) state.Dump {
+	dump := &state.Dump{
+		Accounts: make(map[string]state.DumpAccount),
+	}
+	dump.Next = sdb.DumpToCollector(dump, conf)
+	return *dump
+}
+
+// Dump implements `StateDB` by returning the JSON encoded dump of the accounts of the state
+// plugin.
+func (sdb *stateDB) Dump(conf *state.DumpConfig) []byte {
+	bz, err := json.MarshalIndent(sdb.RawDump(conf), "", "    ")
+	if err != nil {
+		log.Error("failed to dump state", "err", err)
+	}
+	return bz
+}
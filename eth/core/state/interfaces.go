@@ -42,6 +42,8 @@ type Plugin interface {
 	GetContext() context.Context
 	// Error returns the current saved error of the state plugin.
 	Error() error
+	// StateRoot returns a deterministic commitment to the current state of all accounts.
+	StateRoot() common.Hash
 
 	// CreateAccount creates an account with the given `address`.
 	CreateAccount(common.Address)
@@ -87,3 +89,35 @@ type Plugin interface {
 	// function.
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error
 }
+
+// ProofPlugin is implemented by state plugins that are able to prove their state to light clients.
+// The format of the proofs is defined by the host chain.
+type ProofPlugin interface {
+	// GetProof returns the proof of the given account.
+	GetProof(common.Address) ([][]byte, error)
+	// GetBalanceProof returns the proof of the balance of the given account, or
+	// `ErrNoBalanceProof` if balances cannot be proven.
+	GetBalanceProof(common.Address) ([][]byte, error)
+	// GetNonceProof returns the proof of the nonce of the given account.
+	GetNonceProof(common.Address) ([][]byte, error)
+	// GetStorageProof returns the proof of the given storage slot of the given account.
+	GetStorageProof(common.Address, common.Hash) ([][]byte, error)
+	// GetStorageRoot returns the root of the storage trie of the given account.
+	GetStorageRoot(common.Address) common.Hash
+}
+
+// IterablePlugin is implemented by state plugins that are able to iterate over all the accounts
+// in their state, in ascending order of address starting at a given address. It is used to dump
+// the state.
+type IterablePlugin interface {
+	// IterateNonces iterates over the nonces of all accounts with a nonce, until the callback
+	// returns true.
+	IterateNonces(common.Address, func(common.Address, uint64) bool)
+	// IterateBalances iterates over the balances of all accounts, until the callback returns true.
+	IterateBalances(common.Address, func(common.Address, *big.Int) bool)
+	// IterateState iterates over the storage slots of all accounts, until the callback returns
+	// true.
+	IterateState(common.Address, func(common.Address, common.Hash, common.Hash) bool)
+	// IterateCode iterates over the code hashes of all accounts, until the callback returns true.
+	IterateCode(common.Address, func(common.Address, common.Hash) bool)
+}
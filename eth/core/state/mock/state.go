@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/polaris/eth/core/state"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -138,6 +139,9 @@ func NewEmptyStatePlugin() *PluginMock {
 		SnapshotFunc: func() int {
 			return 0
 		},
+		StateRootFunc: func() common.Hash {
+			return types.EmptyRootHash
+		},
 		SubBalanceFunc: func(address common.Address, intMoqParam *big.Int) {
 			if _, ok := Accounts[address]; !ok {
 				panic("acct doesnt exist")
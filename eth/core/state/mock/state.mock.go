@@ -92,6 +92,9 @@ import (
 //			SnapshotFunc: func() int {
 //				panic("mock out the Snapshot method")
 //			},
+//			StateRootFunc: func() common.Hash {
+//				panic("mock out the StateRoot method")
+//			},
 //			SubBalanceFunc: func(address common.Address, intMoqParam *big.Int)  {
 //				panic("mock out the SubBalance method")
 //			},
@@ -177,6 +180,9 @@ type PluginMock struct {
 	// SnapshotFunc mocks the Snapshot method.
 	SnapshotFunc func() int
 
+	// StateRootFunc mocks the StateRoot method.
+	StateRootFunc func() common.Hash
+
 	// SubBalanceFunc mocks the SubBalance method.
 	SubBalanceFunc func(address common.Address, intMoqParam *big.Int)
 
@@ -315,6 +321,9 @@ type PluginMock struct {
 		// Snapshot holds details about calls to the Snapshot method.
 		Snapshot []struct {
 		}
+		// StateRoot holds details about calls to the StateRoot method.
+		StateRoot []struct {
+		}
 		// SubBalance holds details about calls to the SubBalance method.
 		SubBalance []struct {
 			// Address is the address argument value.
@@ -348,6 +357,7 @@ type PluginMock struct {
 	lockSetState          sync.RWMutex
 	lockSetStorage        sync.RWMutex
 	lockSnapshot          sync.RWMutex
+	lockStateRoot         sync.RWMutex
 	lockSubBalance        sync.RWMutex
 }
 
@@ -1161,6 +1171,33 @@ func (mock *PluginMock) SnapshotCalls() []struct {
 	return calls
 }
 
+// StateRoot calls StateRootFunc.
+func (mock *PluginMock) StateRoot() common.Hash {
+	if mock.StateRootFunc == nil {
+		panic("PluginMock.StateRootFunc: method is nil but Plugin.StateRoot was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStateRoot.Lock()
+	mock.calls.StateRoot = append(mock.calls.StateRoot, callInfo)
+	mock.lockStateRoot.Unlock()
+	return mock.StateRootFunc()
+}
+
+// StateRootCalls gets all the calls that were made to StateRoot.
+// Check the length with:
+//
+//	len(mockedPlugin.StateRootCalls())
+func (mock *PluginMock) StateRootCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStateRoot.RLock()
+	calls = mock.calls.StateRoot
+	mock.lockStateRoot.RUnlock()
+	return calls
+}
+
 // SubBalance calls SubBalanceFunc.
 func (mock *PluginMock) SubBalance(address common.Address, intMoqParam *big.Int) {
 	if mock.SubBalanceFunc == nil {
@@ -1051,6 +1051,53 @@ func (_c *Plugin_Snapshot_Call) RunAndReturn(run func() int) *Plugin_Snapshot_Ca
 	return _c
 }
 
+// StateRoot provides a mock function with given fields:
+func (_m *Plugin) StateRoot() common.Hash {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StateRoot")
+	}
+
+	var r0 common.Hash
+	if rf, ok := ret.Get(0).(func() common.Hash); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	return r0
+}
+
+// Plugin_StateRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StateRoot'
+type Plugin_StateRoot_Call struct {
+	*mock.Call
+}
+
+// StateRoot is a helper method to define mock.On call
+func (_e *Plugin_Expecter) StateRoot() *Plugin_StateRoot_Call {
+	return &Plugin_StateRoot_Call{Call: _e.mock.On("StateRoot")}
+}
+
+func (_c *Plugin_StateRoot_Call) Run(run func()) *Plugin_StateRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Plugin_StateRoot_Call) Return(_a0 common.Hash) *Plugin_StateRoot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Plugin_StateRoot_Call) RunAndReturn(run func() common.Hash) *Plugin_StateRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubBalance provides a mock function with given fields: _a0, _a1
 func (_m *Plugin) SubBalance(_a0 common.Address, _a1 *big.Int) {
 	_m.Called(_a0, _a1)
@@ -29,4 +29,8 @@ const (
 	Insert
 	Finalize
 	Latest
+	// Simulation is the state of simulated calls, such as `eth_call` and `eth_estimateGas`. Each
+	// state is a read-only branch of the latest committed state, isolated from block processing
+	// and from other simulations.
+	Simulation
 )
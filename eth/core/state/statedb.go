@@ -22,11 +22,14 @@ package state
 
 import (
 	"context"
+	"errors"
+	"math/big"
 
 	"github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/core/state/journal"
 	"github.com/berachain/polaris/lib/snapshot"
 	libtypes "github.com/berachain/polaris/lib/types"
+	"github.com/berachain/polaris/lib/utils"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -57,8 +60,19 @@ type stateDB struct {
 
 	// rules is used to store the rules for the chain.
 	rules *params.Rules
+
+	// preimages are the SHA3 preimages recorded by the EVM, if preimage recording is enabled.
+	preimages map[common.Hash][]byte
 }
 
+var (
+	// ErrProofsNotSupported is returned when the state plugin is not able to prove its state.
+	ErrProofsNotSupported = errors.New("state proofs are not supported by the host chain")
+	// ErrNoBalanceProof is returned when the state plugin is able to prove its state, but not the
+	// balances of the accounts, such as when they are kept outside of the proven store.
+	ErrNoBalanceProof = errors.New("balance proofs are not supported by the host chain")
+)
+
 type (
 	// StateDB is an alias for StateDBI.
 	StateDB = state.StateDBI //nolint:revive // to match geth naming.
@@ -68,8 +82,22 @@ type (
 		StateDB
 		GetContext() context.Context
 	}
+
+	// BlockBinder is implemented by StateDBs, whose precompiles can be bound to the block that is
+	// executed on the state.
+	BlockBinder interface {
+		BindBlock(number *big.Int, time uint64)
+	}
 )
 
+// BindBlock binds the precompiles of the given StateDB to the block with the given number and
+// timestamp, if the StateDB supports it.
+func BindBlock(sdb StateDB, number *big.Int, time uint64) {
+	if bb, ok := sdb.(BlockBinder); ok {
+		bb.BindBlock(number, time)
+	}
+}
+
 // NewStateDB returns a vm.PolarStateDB with the given StatePlugin and new journals.
 func NewStateDB(sp Plugin, pp precompile.Plugin) PolarStateDB {
 	return newStateDBWithJournals(
@@ -118,9 +146,29 @@ func (sdb *stateDB) GetPlugin() Plugin {
 }
 
 func (sdb *stateDB) GetPrecompileManager() any {
+	return sdb.precompiles()
+}
+
+// precompiles returns the precompile plugin of the StateDB, which also gets the precompiles kept
+// in the state of the current context if the plugin supports it.
+func (sdb *stateDB) precompiles() precompile.Plugin {
+	if cp, ok := sdb.pp.(precompile.ContextualPlugin); ok {
+		return cp.WithContext(sdb.Plugin.GetContext())
+	}
 	return sdb.pp
 }
 
+// BindBlock binds the precompiles of the StateDB to the block with the given number and
+// timestamp, so that only the precompiles active in the block are found. It is a no-op if the
+// precompile plugin does not activate its precompiles by schedule.
+//
+// BindBlock implements BlockBinder.
+func (sdb *stateDB) BindBlock(number *big.Int, time uint64) {
+	if sp, ok := sdb.pp.(precompile.ScheduledPlugin); ok {
+		sdb.pp = sp.AtBlock(number, time)
+	}
+}
+
 // =============================================================================
 // Snapshot
 // =============================================================================
@@ -146,19 +194,20 @@ func (sdb *stateDB) Finalise(bool) {
 	sdb.ctrl.Finalize()
 }
 
-// Intermediate root is called in lieu of Finalise pre-Byzantium. They are
-// equivalent at the moment in Polaris as we do not leverage the state root.
-func (sdb *stateDB) IntermediateRoot(bool) common.Hash {
-	sdb.Finalise(true)
-	return common.Hash{}
+// IntermediateRoot finalises the current state and returns the state root committing to it, as
+// computed by the state plugin.
+func (sdb *stateDB) IntermediateRoot(deleteEmptyObjects bool) common.Hash {
+	sdb.Finalise(deleteEmptyObjects)
+	return sdb.Plugin.StateRoot()
 }
 
 // Commit implements vm.PolarStateDB.
-func (sdb *stateDB) Commit(_ uint64, _ bool) (common.Hash, error) {
+func (sdb *stateDB) Commit(_ uint64, deleteEmptyObjects bool) (common.Hash, error) {
+	root := sdb.IntermediateRoot(deleteEmptyObjects)
 	if err := sdb.Error(); err != nil {
 		return common.Hash{}, err
 	}
-	return common.Hash{}, nil
+	return root, nil
 }
 
 // =============================================================================
@@ -204,14 +253,21 @@ func (sdb *stateDB) Prepare(rules params.Rules, sender, coinbase common.Address,
 // PreImage
 // =============================================================================
 
-// AddPreimage implements the the vm.PolarStateDB interface, but currently
-// performs a no-op since the EnablePreimageRecording flag is disabled.
-func (sdb *stateDB) AddPreimage(_ common.Hash, _ []byte) {}
+// AddPreimage implements the vm.PolarStateDB interface by recording the SHA3 preimage of the given
+// hash. It is only called by the EVM if the EnablePreimageRecording flag is enabled. The
+// preimages are not reverted with snapshots, as they do not affect the state.
+func (sdb *stateDB) AddPreimage(hash common.Hash, preimage []byte) {
+	if sdb.preimages == nil {
+		sdb.preimages = make(map[common.Hash][]byte)
+	}
+	if _, found := sdb.preimages[hash]; !found {
+		sdb.preimages[hash] = common.CopyBytes(preimage)
+	}
+}
 
-// AddPreimage implements the the `StateDB“ interface, but currently
-// performs a no-op since the EnablePreimageRecording flag is disabled.
+// Preimages implements the `StateDB` interface by returning the recorded SHA3 preimages.
 func (sdb *stateDB) Preimages() map[common.Hash][]byte {
-	return nil
+	return sdb.preimages
 }
 
 // =============================================================================
@@ -222,8 +278,8 @@ func (sdb *stateDB) Preimages() map[common.Hash][]byte {
 // code associated with the given account.
 func (sdb *stateDB) GetCode(addr common.Address) []byte {
 	// We return a single byte for client compatibility w/precompiles.
-	if sdb.pp != nil {
-		if _, ok := sdb.pp.Get(addr, sdb.rules); ok {
+	if pp := sdb.precompiles(); pp != nil {
+		if _, ok := pp.Get(addr, sdb.rules); ok {
 			return []byte{0x01}
 		}
 	}
@@ -242,22 +298,14 @@ func (sdb *stateDB) GetCodeSize(addr common.Address) int {
 
 // Copy returns a new statedb with cloned plugin and journals.
 func (sdb *stateDB) Copy() StateDB {
-	return newStateDBWithJournals(
+	cpy := newStateDBWithJournals(
 		sdb.Plugin.Clone(), sdb.pp, sdb.Log.Clone(), sdb.Refund.Clone(),
 		sdb.Accesslist.Clone(), sdb.SelfDestructs.Clone(), sdb.TransientStorage.Clone(),
 	)
-}
-
-func (sdb *stateDB) DumpToCollector(_ state.DumpCollector, _ *state.DumpConfig) []byte {
-	return nil
-}
-
-func (sdb *stateDB) Dump(_ *state.DumpConfig) []byte {
-	return nil
-}
-
-func (sdb *stateDB) RawDump(_ *state.DumpConfig) state.Dump {
-	return state.Dump{}
+	for hash, preimage := range sdb.preimages {
+		cpy.AddPreimage(hash, preimage)
+	}
+	return cpy
 }
 
 func (sdb *stateDB) Database() state.Database {
@@ -272,18 +320,60 @@ func (sdb *stateDB) StorageTrie(_ common.Address) (state.Trie, error) {
 	return nil, nil
 }
 
-func (sdb *stateDB) GetStorageProof(_ common.Address, _ common.Hash) ([][]byte, error) {
-	return nil, nil
+func (sdb *stateDB) GetOrNewStateObject(_ common.Address) *state.StateObject {
+	return nil
 }
 
-func (sdb *stateDB) GetProof(_ common.Address) ([][]byte, error) {
-	return nil, nil
+// =============================================================================
+// Proofs
+// =============================================================================
+
+// GetProof implements `StateDB` by returning the proof of the given account from the state
+// plugin, if it supports proofs.
+func (sdb *stateDB) GetProof(addr common.Address) ([][]byte, error) {
+	pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin)
+	if !ok {
+		return nil, ErrProofsNotSupported
+	}
+	return pp.GetProof(addr)
 }
 
-func (sdb *stateDB) GetOrNewStateObject(_ common.Address) *state.StateObject {
-	return nil
+// GetBalanceProof returns the proof of the balance of the given account from the state plugin,
+// if it supports proofs.
+func (sdb *stateDB) GetBalanceProof(addr common.Address) ([][]byte, error) {
+	pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin)
+	if !ok {
+		return nil, ErrProofsNotSupported
+	}
+	return pp.GetBalanceProof(addr)
 }
 
-func (sdb *stateDB) GetStorageRoot(_ common.Address) common.Hash {
-	return common.Hash{}
+// GetNonceProof returns the proof of the nonce of the given account from the state plugin, if it
+// supports proofs.
+func (sdb *stateDB) GetNonceProof(addr common.Address) ([][]byte, error) {
+	pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin)
+	if !ok {
+		return nil, ErrProofsNotSupported
+	}
+	return pp.GetNonceProof(addr)
+}
+
+// GetStorageProof implements `StateDB` by returning the proof of the given storage slot from the
+// state plugin, if it supports proofs.
+func (sdb *stateDB) GetStorageProof(addr common.Address, slot common.Hash) ([][]byte, error) {
+	pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin)
+	if !ok {
+		return nil, ErrProofsNotSupported
+	}
+	return pp.GetStorageProof(addr, slot)
+}
+
+// GetStorageRoot implements `StateDB` by returning the storage root of the given account from
+// the state plugin, if it supports proofs.
+func (sdb *stateDB) GetStorageRoot(addr common.Address) common.Hash {
+	pp, ok := utils.GetAs[ProofPlugin](sdb.Plugin)
+	if !ok {
+		return common.Hash{}
+	}
+	return pp.GetStorageRoot(addr)
 }
@@ -33,6 +33,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -76,6 +77,45 @@ var _ = Describe("StateDB", func() {
 		Expect(sdb.HasSelfDestructed(bob)).To(BeTrue())
 	})
 
+	It("should record preimages", func() {
+		Expect(sdb.Preimages()).To(BeEmpty())
+		preimage := []byte{1, 2, 3}
+		hash := crypto.Keccak256Hash(preimage)
+		sdb.AddPreimage(hash, preimage)
+		preimage[0] = 0
+		Expect(sdb.Preimages()).To(Equal(map[common.Hash][]byte{hash: {1, 2, 3}}))
+
+		sp.CloneFunc = func() state.Plugin { return sp }
+		Expect(sdb.Copy().Preimages()).To(Equal(sdb.Preimages()))
+	})
+
+	It("should return the state root of the plugin", func() {
+		root := common.Hash{0x01}
+		sp.StateRootFunc = func() common.Hash { return root }
+		Expect(sdb.IntermediateRoot(true)).To(Equal(root))
+		Expect(sp.FinalizeCalls()).To(HaveLen(1))
+
+		committed, err := sdb.Commit(0, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(committed).To(Equal(root))
+
+		sp.ErrorFunc = func() error { return errors.New("db error") }
+		_, err = sdb.Commit(0, true)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not prove state without a proof plugin", func() {
+		pp, ok := sdb.(state.ProofPlugin)
+		Expect(ok).To(BeTrue())
+		_, err := pp.GetProof(alice)
+		Expect(err).To(MatchError(state.ErrProofsNotSupported))
+		_, err = pp.GetNonceProof(alice)
+		Expect(err).To(MatchError(state.ErrProofsNotSupported))
+		_, err = pp.GetStorageProof(alice, slot)
+		Expect(err).To(MatchError(state.ErrProofsNotSupported))
+		Expect(sdb.GetStorageRoot(alice)).To(Equal(common.Hash{}))
+	})
+
 	It("should snapshot/revert", func() {
 		Expect(func() {
 			id := sdb.Snapshot()
@@ -138,7 +138,10 @@ func newGethExecutionLayer(
 	log.SetDefault(logger)
 
 	// Create a new Polaris backend
-	backend := polar.New(&cfg.Polar, host, engine, gethNode, allowUnprotectedTxs)
+	backend, err := polar.New(&cfg.Polar, host, engine, gethNode, allowUnprotectedTxs)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return a new ExecutionLayer with the created gethNode and backend
 	return &ExecutionLayer{
@@ -55,4 +55,5 @@ var DefaultChainConfig = &params.ChainConfig{
 	TerminalTotalDifficulty:       big.NewInt(0),
 	TerminalTotalDifficultyPassed: true,
 	ShanghaiTime:                  &zero,
+	CancunTime:                    &zero,
 }
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// ErrCallQueueFull is returned when a call is rejected because all the workers are busy and
+	// the queue of waiting calls is full.
+	ErrCallQueueFull = errors.New("too many pending calls, try again later")
+	// ErrBlobBaseFeeOverride is returned when the gas estimation is asked to override the blob
+	// base fee, which is not a field of the header.
+	ErrBlobBaseFeeOverride = errors.New("blob base fee override is not supported by estimateGas")
+)
+
+// callPool bounds the number of calls executed concurrently and the number of calls waiting for
+// a worker, so that a burst of calls cannot starve block processing of CPU.
+type callPool struct {
+	// workers holds a token for every call being executed.
+	workers chan struct{}
+	// admitted holds a token for every call being executed or waiting for a worker.
+	admitted chan struct{}
+}
+
+// newCallPool returns a pool of the given number of workers, which defaults to the number of
+// CPUs, and of the given queue depth.
+func newCallPool(workers, queueDepth int) *callPool {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if queueDepth < 0 {
+		queueDepth = 0
+	}
+	return &callPool{
+		workers:  make(chan struct{}, workers),
+		admitted: make(chan struct{}, workers+queueDepth),
+	}
+}
+
+// run executes fn on a worker, once one is free. It returns `ErrCallQueueFull` if the queue is
+// full, or the error of ctx if it is done before a worker is free.
+func (cp *callPool) run(ctx context.Context, fn func()) error {
+	select {
+	case cp.admitted <- struct{}{}:
+	default:
+		return ErrCallQueueFull
+	}
+	defer func() { <-cp.admitted }()
+
+	select {
+	case cp.workers <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-cp.workers }()
+
+	fn()
+	return nil
+}
+
+// simulationBackend is an `ethapi.Backend` that serves the state of the calls from the
+// simulation mode of the state plugin.
+type simulationBackend struct {
+	EthBackend
+	// stateOverrides are the state overrides of the calls, if set. The overridden accounts which
+	// do not exist are created in the served states, so that the overrides take effect.
+	stateOverrides *ethapi.StateOverride
+	// blockOverrides are applied to the headers served to the calls, if set.
+	blockOverrides *ethapi.BlockOverrides
+}
+
+// StateAndHeaderByNumberOrHash implements `ethapi.Backend`.
+func (sb simulationBackend) StateAndHeaderByNumberOrHash(
+	ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash,
+) (state.StateDB, *ethtypes.Header, error) {
+	statedb, header, err := sb.SimulationStateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil || statedb == nil || header == nil {
+		return statedb, header, err
+	}
+	if sb.stateOverrides != nil {
+		createAccounts(statedb, sb.stateOverrides)
+	}
+	if sb.blockOverrides != nil {
+		header = overrideHeader(header, sb.blockOverrides)
+	}
+	return statedb, header, nil
+}
+
+// StateAndHeaderByNumber implements `ethapi.Backend`.
+func (sb simulationBackend) StateAndHeaderByNumber(
+	ctx context.Context, number rpc.BlockNumber,
+) (state.StateDB, *ethtypes.Header, error) {
+	return sb.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithNumber(number))
+}
+
+// createAccounts creates the overridden accounts which do not exist in the given state, as the
+// state plugin only stores the code and storage of existing accounts.
+func createAccounts(statedb state.StateDB, diff *ethapi.StateOverride) {
+	for addr := range *diff {
+		if !statedb.Exist(addr) {
+			statedb.CreateAccount(addr)
+		}
+	}
+}
+
+// overrideHeader returns a copy of the given header with the given block overrides applied.
+func overrideHeader(header *ethtypes.Header, diff *ethapi.BlockOverrides) *ethtypes.Header {
+	header = ethtypes.CopyHeader(header)
+	if diff.Number != nil {
+		header.Number = diff.Number.ToInt()
+	}
+	if diff.Difficulty != nil {
+		header.Difficulty = diff.Difficulty.ToInt()
+	}
+	if diff.Time != nil {
+		header.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		header.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		header.Coinbase = *diff.Coinbase
+	}
+	if diff.Random != nil {
+		header.MixDigest = *diff.Random
+	}
+	if diff.BaseFee != nil {
+		header.BaseFee = diff.BaseFee.ToInt()
+	}
+	return header
+}
+
+// Call executes the given transaction on the state of the given block, like go-ethereum's
+// `eth_call`, but on a worker of the call pool and on a state isolated from block processing and
+// from other calls.
+func (api *ethAPI) Call(
+	ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+	overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+) (hexutil.Bytes, error) {
+	simulator := api.simulator
+	if overrides != nil {
+		simulator = ethapi.NewBlockChainAPI(
+			simulationBackend{EthBackend: api.b, stateOverrides: overrides},
+		)
+	}
+
+	var (
+		res hexutil.Bytes
+		err error
+	)
+	if poolErr := api.calls.run(ctx, func() {
+		res, err = simulator.Call(ctx, args, blockNrOrHash, overrides, blockOverrides)
+	}); poolErr != nil {
+		return nil, poolErr
+	}
+	return res, err
+}
+
+// EstimateGas estimates the gas needed to execute the given transaction on the state of the
+// given block, like go-ethereum's `eth_estimateGas`, but on a worker of the call pool and on a
+// state isolated from block processing and from other calls. Unlike go-ethereum, it also takes
+// the block overrides of `eth_call`.
+func (api *ethAPI) EstimateGas(
+	ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+	overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+) (hexutil.Uint64, error) {
+	if blockOverrides != nil && blockOverrides.BlobBaseFee != nil {
+		return 0, ErrBlobBaseFeeOverride
+	}
+	simulator := api.simulator
+	if overrides != nil || blockOverrides != nil {
+		simulator = ethapi.NewBlockChainAPI(simulationBackend{
+			EthBackend: api.b, stateOverrides: overrides, blockOverrides: blockOverrides,
+		})
+	}
+
+	var (
+		res hexutil.Uint64
+		err error
+	)
+	if poolErr := api.calls.run(ctx, func() {
+		res, err = simulator.EstimateGas(ctx, args, blockNrOrHash, overrides)
+	}); poolErr != nil {
+		return 0, poolErr
+	}
+	return res, err
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	polarstate "github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// AccountRangeMaxResults is the maximum number of accounts returned by a single state dump.
+const AccountRangeMaxResults = 256
+
+// ErrUnknownPreimage is returned when the preimage of a hash was not recorded.
+var ErrUnknownPreimage = errors.New("unknown preimage")
+
+// DebugBackend is the collection of methods required to satisfy the Polaris specific debug
+// RPC API.
+type DebugBackend interface {
+	ChainConfig() *params.ChainConfig
+	GetBadBlocks() []*ethtypes.Block
+	GetBadBlockReason(common.Hash) string
+	Preimage(common.Hash) []byte
+	StateAndHeaderByNumberOrHash(
+		context.Context, rpc.BlockNumberOrHash,
+	) (polarstate.StateDB, *ethtypes.Header, error)
+}
+
+// DebugAPI is the collection of debug RPC API methods that Polaris serves differently from
+// go-ethereum.
+type DebugAPI interface {
+	GetBadBlocks(ctx context.Context) ([]*BadBlockArgs, error)
+	Preimage(ctx context.Context, hash common.Hash) (hexutil.Bytes, error)
+	DumpBlock(ctx context.Context, blockNr rpc.BlockNumber) (state.Dump, error)
+	AccountRange(
+		ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, start hexutil.Bytes,
+		maxResults int, nocode, nostorage, incompletes bool,
+	) (state.Dump, error)
+}
+
+// BadBlockArgs represents the entries in the list returned when bad blocks are queried. Next to
+// the fields returned by go-ethereum, it contains the reason the block was rejected.
+type BadBlockArgs struct {
+	Hash   common.Hash            `json:"hash"`
+	Block  map[string]interface{} `json:"block"`
+	RLP    string                 `json:"rlp"`
+	Reason string                 `json:"reason"`
+}
+
+// debugAPI offers debug related RPC methods.
+type debugAPI struct {
+	b DebugBackend
+}
+
+// NewDebugAPI creates a new debug API instance.
+func NewDebugAPI(b DebugBackend) DebugAPI {
+	return &debugAPI{b}
+}
+
+// GetBadBlocks returns a list of the last blocks that were rejected by the chain, along with the
+// reason they were rejected.
+func (api *debugAPI) GetBadBlocks(context.Context) ([]*BadBlockArgs, error) {
+	blocks := api.b.GetBadBlocks()
+	results := make([]*BadBlockArgs, 0, len(blocks))
+	for _, block := range blocks {
+		var blockRlp string
+		if rlpBytes, err := rlp.EncodeToBytes(block); err != nil {
+			blockRlp = err.Error()
+		} else {
+			blockRlp = fmt.Sprintf("%#x", rlpBytes)
+		}
+		results = append(results, &BadBlockArgs{
+			Hash:   block.Hash(),
+			Block:  ethapi.RPCMarshalBlock(block, true, true, api.b.ChainConfig()),
+			RLP:    blockRlp,
+			Reason: api.b.GetBadBlockReason(block.Hash()),
+		})
+	}
+	return results, nil
+}
+
+// DumpBlock returns the first `AccountRangeMaxResults` accounts of the state at the given block
+// number.
+func (api *debugAPI) DumpBlock(ctx context.Context, blockNr rpc.BlockNumber) (state.Dump, error) {
+	sdb, header, err := api.b.StateAndHeaderByNumberOrHash(
+		ctx, rpc.BlockNumberOrHashWithNumber(blockNr),
+	)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	return dumpState(sdb, header, &state.DumpConfig{
+		OnlyWithAddresses: true,
+		Max:               AccountRangeMaxResults,
+	}), nil
+}
+
+// AccountRange returns a page of the accounts of the state at the given block, in ascending order
+// of address starting at the given address. The `Next` of the returned dump is the address to
+// start the next page at.
+func (api *debugAPI) AccountRange(
+	ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, start hexutil.Bytes,
+	maxResults int, nocode, nostorage, incompletes bool,
+) (state.Dump, error) {
+	sdb, header, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	if maxResults > AccountRangeMaxResults || maxResults <= 0 {
+		maxResults = AccountRangeMaxResults
+	}
+	return dumpState(sdb, header, &state.DumpConfig{
+		SkipCode:          nocode,
+		SkipStorage:       nostorage,
+		OnlyWithAddresses: !incompletes,
+		Start:             start,
+		Max:               uint64(maxResults),
+	}), nil
+}
+
+// dumpState returns the dump of the given state of the block with the given header. The root of
+// the dump is the root of the header, as the state does not compute its own root when dumped.
+func dumpState(
+	sdb polarstate.StateDB, header *ethtypes.Header, conf *state.DumpConfig,
+) state.Dump {
+	dump := &state.Dump{
+		Accounts: make(map[string]state.DumpAccount),
+	}
+	dump.OnRoot(header.Root)
+	dump.Next = sdb.DumpToCollector(dump, conf)
+	return *dump
+}
+
+// Preimage returns the SHA3 preimage of the given hash, if it was recorded. Preimages are only
+// recorded if the node enables preimage recording.
+func (api *debugAPI) Preimage(_ context.Context, hash common.Hash) (hexutil.Bytes, error) {
+	if preimage := api.b.Preimage(hash); preimage != nil {
+		return preimage, nil
+	}
+	return nil, ErrUnknownPreimage
+}
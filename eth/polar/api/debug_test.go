@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi_test
+
+import (
+	"context"
+	"testing"
+
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPolarAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/polar/api")
+}
+
+var _ = Describe("Debug API", func() {
+	var (
+		backend *preimagesBackend
+		api     polarapi.DebugAPI
+	)
+
+	BeforeEach(func() {
+		backend = &preimagesBackend{preimages: make(map[common.Hash][]byte)}
+		api = polarapi.NewDebugAPI(backend)
+	})
+
+	When("Preimage", func() {
+		It("should return a recorded preimage", func() {
+			preimage := []byte("polaris")
+			backend.preimages[crypto.Keccak256Hash(preimage)] = preimage
+
+			res, err := api.Preimage(context.Background(), crypto.Keccak256Hash(preimage))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(hexutil.Bytes(preimage)))
+		})
+
+		It("should fail with ErrUnknownPreimage if the preimage was not recorded", func() {
+			res, err := api.Preimage(context.Background(), crypto.Keccak256Hash([]byte("polaris")))
+			Expect(err).To(MatchError(polarapi.ErrUnknownPreimage))
+			Expect(res).To(BeNil())
+		})
+	})
+})
+
+// preimagesBackend is a debug backend which only serves the preimages it recorded.
+type preimagesBackend struct {
+	polarapi.DebugBackend
+	preimages map[common.Hash][]byte
+}
+
+func (b *preimagesBackend) Preimage(hash common.Hash) []byte {
+	return b.preimages[hash]
+}
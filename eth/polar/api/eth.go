@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// EthBackend is the collection of methods required to satisfy the Polaris specific eth
+// RPC API.
+type EthBackend interface {
+	ethapi.Backend
+	SimulationStateAndHeaderByNumberOrHash(
+		context.Context, rpc.BlockNumberOrHash,
+	) (state.StateDB, *ethtypes.Header, error)
+	RPCCallWorkers() int
+	RPCCallQueueDepth() int
+}
+
+// EthAPI is the collection of eth RPC API methods that Polaris serves differently from
+// go-ethereum.
+type EthAPI interface {
+	GetProof(
+		ctx context.Context, address common.Address,
+		storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash,
+	) (*AccountResult, error)
+	Call(
+		ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+		overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+	) (hexutil.Bytes, error)
+	EstimateGas(
+		ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+		overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+	) (hexutil.Uint64, error)
+}
+
+// AccountResult is the result of `eth_getProof`. It has the shape of EIP-1186, but the proofs are
+// the proofs of the keys in the evm store of the host chain, as returned by the state plugin,
+// rather than Merkle-Patricia proofs. Every proof is a list of hex encoded proof operations.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	BalanceProof []string        `json:"balanceProof"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	NonceProof   []string        `json:"nonceProof"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the value and proof of a single storage slot in an `AccountResult`.
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+// prover is implemented by state databases which are able to prove their state.
+type prover interface {
+	GetProof(common.Address) ([][]byte, error)
+	GetBalanceProof(common.Address) ([][]byte, error)
+	GetNonceProof(common.Address) ([][]byte, error)
+	GetStorageProof(common.Address, common.Hash) ([][]byte, error)
+}
+
+// ethAPI offers the Polaris specific eth RPC methods.
+type ethAPI struct {
+	b EthBackend
+	// simulator executes the calls on the simulation state of the backend.
+	simulator *ethapi.BlockChainAPI
+	// calls bounds the concurrent calls.
+	calls *callPool
+}
+
+// NewEthAPI creates a new eth API instance.
+func NewEthAPI(b EthBackend) EthAPI {
+	return &ethAPI{
+		b:         b,
+		simulator: ethapi.NewBlockChainAPI(simulationBackend{EthBackend: b}),
+		calls:     newCallPool(b.RPCCallWorkers(), b.RPCCallQueueDepth()),
+	}
+}
+
+// GetProof returns the account and storage values of the specified account, including the proofs
+// of the keys under which they are stored in the host chain.
+func (api *ethAPI) GetProof(
+	ctx context.Context, address common.Address,
+	storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash,
+) (*AccountResult, error) {
+	var (
+		keys         = make([]common.Hash, len(storageKeys))
+		keyLengths   = make([]int, len(storageKeys))
+		storageProof = make([]StorageResult, len(storageKeys))
+	)
+	// Deserialize all keys. This prevents state access on invalid input.
+	for i, hexKey := range storageKeys {
+		var err error
+		if keys[i], keyLengths[i], err = decodeHash(hexKey); err != nil {
+			return nil, err
+		}
+	}
+
+	statedb, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	sp, ok := statedb.(prover)
+	if !ok {
+		return nil, state.ErrProofsNotSupported
+	}
+
+	// Create the proofs for the storage keys.
+	for i, key := range keys {
+		// Output key encoding is a bit special: if the input was a 32-byte hash, it is returned as
+		// such. Otherwise, we apply the QUANTITY encoding mandated by the JSON-RPC spec.
+		var outputKey string
+		if keyLengths[i] != common.HashLength {
+			outputKey = hexutil.EncodeBig(key.Big())
+		} else {
+			outputKey = hexutil.Encode(key[:])
+		}
+
+		var proof [][]byte
+		if proof, err = sp.GetStorageProof(address, key); err != nil {
+			return nil, err
+		}
+		storageProof[i] = StorageResult{
+			Key:   outputKey,
+			Value: (*hexutil.Big)(statedb.GetState(address, key).Big()),
+			Proof: encodeProof(proof),
+		}
+	}
+
+	// Create the account, nonce and balance proofs.
+	accountProof, err := sp.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+	nonceProof, err := sp.GetNonceProof(address)
+	if err != nil {
+		return nil, err
+	}
+	// The balance proof is omitted if the host chain cannot prove balances.
+	balanceProof, err := sp.GetBalanceProof(address)
+	if err != nil && !errors.Is(err, state.ErrNoBalanceProof) {
+		return nil, err
+	}
+
+	return &AccountResult{
+		Address:      address,
+		AccountProof: encodeProof(accountProof),
+		Balance:      (*hexutil.Big)(statedb.GetBalance(address)),
+		BalanceProof: encodeProof(balanceProof),
+		CodeHash:     statedb.GetCodeHash(address),
+		Nonce:        hexutil.Uint64(statedb.GetNonce(address)),
+		NonceProof:   encodeProof(nonceProof),
+		StorageHash:  statedb.GetStorageRoot(address),
+		StorageProof: storageProof,
+	}, statedb.Error()
+}
+
+// encodeProof hex encodes every operation of the given proof.
+func encodeProof(proof [][]byte) []string {
+	encoded := make([]string, len(proof))
+	for i, op := range proof {
+		encoded[i] = hexutil.Encode(op)
+	}
+	return encoded
+}
+
+// decodeHash parses a hex-encoded 32-byte hash. The input may optionally be prefixed by 0x and
+// can have a byte length up to 32.
+func decodeHash(s string) (common.Hash, int, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	if (len(s) & 1) > 0 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Hash{}, 0, errors.New("hex string invalid")
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, len(b), errors.New("hex string too long, want at most 32 bytes")
+	}
+	return common.BytesToHash(b), len(b), nil
+}
@@ -57,6 +57,8 @@ import (
 type (
 	APIBackend interface {
 		ethapi.Backend
+		polarapi.DebugBackend
+		polarapi.EthBackend
 		polarapi.NetBackend
 		polarapi.Web3Backend
 		tracers.Backend
@@ -72,6 +74,15 @@ type (
 		// PeerCount returns the current number of peers connected to the host chain.
 		PeerCount(ctx context.Context) (uint64, error)
 	}
+
+	// PendingProvider defines methods that allow the chain to serve the pending block, which is
+	// continuously built by the miner of the host chain.
+	PendingProvider interface {
+		// Pending returns the pending block, its receipts and a read-only view of the state
+		// after the pending block, or nil if no pending block has been built yet. The state is
+		// shared and must be copied before it is modified.
+		Pending() (*ethtypes.Block, ethtypes.Receipts, state.StateDB)
+	}
 )
 
 // backend represents the backend for the JSON-RPC service.
@@ -150,9 +161,25 @@ func (b *backend) FeeHistory(
 	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
 }
 
-// ChainDb is unused in Polaris.
+// ChainDb returns the database of the bad blocks of the chain, Polaris keeps no other chain data
+// in it.
 func (b *backend) ChainDb() ethdb.Database { //nolint:stylecheck // conforms to interface.
-	return ethdb.Database(nil)
+	return b.polar.blockchain.ChainDb()
+}
+
+// Preimage returns the recorded SHA3 preimage of the given hash, or nil if it is not known.
+func (b *backend) Preimage(hash common.Hash) []byte {
+	return b.polar.blockchain.Preimage(hash)
+}
+
+// GetBadBlocks returns the most recent blocks that were rejected by the chain.
+func (b *backend) GetBadBlocks() []*ethtypes.Block {
+	return b.polar.blockchain.GetBadBlocks()
+}
+
+// GetBadBlockReason returns why the bad block with the given hash was rejected.
+func (b *backend) GetBadBlockReason(hash common.Hash) string {
+	return b.polar.blockchain.GetBadBlockReason(hash)
 }
 
 // AccountManager is unused in Polaris.
@@ -177,6 +204,16 @@ func (b *backend) RPCEVMTimeout() time.Duration {
 	return b.cfg.RPCEVMTimeout
 }
 
+// RPCCallWorkers returns the number of eth_call variants executed concurrently over rpc.
+func (b *backend) RPCCallWorkers() int {
+	return b.cfg.RPCCallWorkers
+}
+
+// RPCCallQueueDepth returns the number of eth_call variants waiting for a worker over rpc.
+func (b *backend) RPCCallQueueDepth() int {
+	return b.cfg.RPCCallQueueDepth
+}
+
 // RPCTxFeeCap returns the global gas price cap for transactions over rpc.
 func (b *backend) RPCTxFeeCap() float64 {
 	return b.cfg.RPCTxFeeCap
@@ -203,9 +240,8 @@ func (b *backend) HeaderByNumber(
 ) (*ethtypes.Header, error) {
 	switch number {
 	case rpc.PendingBlockNumber:
-		// TODO: handle "miner" stuff, Pending block is only known by the miner
-		block := b.polar.miner.PendingBlock()
-		if block != nil {
+		// Pending block is only known by the miner
+		if block, _, _ := b.pending(); block != nil {
 			return block.Header(), nil
 		}
 		// To improve client compatibility we return the latest state if
@@ -258,7 +294,7 @@ func (b *backend) BlockByNumber(
 	// Pending block is only known by the miner
 	switch number {
 	case rpc.PendingBlockNumber:
-		block := b.polar.miner.PendingBlock()
+		block, _, _ := b.pending()
 		if block == nil {
 			// To improve client compatibility we return the latest state if
 			// pending is not available.
@@ -330,6 +366,43 @@ func (b *backend) StateAndHeaderByNumber(
 	ctx context.Context,
 	number rpc.BlockNumber,
 ) (state.StateDB, *ethtypes.Header, error) {
+	return b.stateAndHeaderByNumber(ctx, number, b.polar.blockchain.StateAtBlockNumber)
+}
+
+func (b *backend) StateAndHeaderByNumberOrHash(
+	ctx context.Context,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (state.StateDB, *ethtypes.Header, error) {
+	return b.stateAndHeaderByNumberOrHash(
+		ctx, blockNrOrHash, b.polar.blockchain.StateAtBlockNumber,
+	)
+}
+
+// SimulationStateAndHeaderByNumberOrHash returns the state to simulate a call on and the header of
+// the given block. The state is isolated from block processing and from other calls.
+func (b *backend) SimulationStateAndHeaderByNumberOrHash(
+	ctx context.Context,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (state.StateDB, *ethtypes.Header, error) {
+	return b.stateAndHeaderByNumberOrHash(
+		ctx, blockNrOrHash, b.polar.blockchain.StateForSimulation,
+	)
+}
+
+// stateAndHeaderByNumber returns the header of the given block and its state, as returned by
+// stateAt.
+func (b *backend) stateAndHeaderByNumber(
+	ctx context.Context,
+	number rpc.BlockNumber,
+	stateAt func(uint64) (state.StateDB, error),
+) (state.StateDB, *ethtypes.Header, error) {
+	// Pending state is only known by the miner, it is copied since the caller may modify it.
+	if number == rpc.PendingBlockNumber {
+		if block, _, state := b.pending(); block != nil && state != nil {
+			return state.Copy(), block.Header(), nil
+		}
+	}
+
 	// Otherwise resolve the block number and return its state
 	header, err := b.HeaderByNumber(ctx, number)
 	if err != nil {
@@ -342,7 +415,7 @@ func (b *backend) StateAndHeaderByNumber(
 	b.logger.Debug("called eth.rpc.backend.StateAndHeaderByNumber", "header", header)
 
 	// StateAtBlockNumber returns nil if the number is not found
-	state, err := b.polar.blockchain.StateAtBlockNumber(header.Number.Uint64())
+	state, err := stateAt(header.Number.Uint64())
 	if err != nil {
 		b.logger.Error("eth.rpc.backend.StateAndHeaderByNumber", "number", number, "err", err)
 		return nil, nil, err
@@ -351,12 +424,15 @@ func (b *backend) StateAndHeaderByNumber(
 	return state, header, nil
 }
 
-func (b *backend) StateAndHeaderByNumberOrHash(
+// stateAndHeaderByNumberOrHash returns the header of the given block and its state, as returned by
+// stateAt.
+func (b *backend) stateAndHeaderByNumberOrHash(
 	ctx context.Context,
 	blockNrOrHash rpc.BlockNumberOrHash,
+	stateAt func(uint64) (state.StateDB, error),
 ) (state.StateDB, *ethtypes.Header, error) {
 	if blockNr, ok := blockNrOrHash.Number(); ok {
-		return b.StateAndHeaderByNumber(ctx, blockNr)
+		return b.stateAndHeaderByNumber(ctx, blockNr, stateAt)
 	}
 
 	if hash, ok := blockNrOrHash.Hash(); ok {
@@ -372,7 +448,7 @@ func (b *backend) StateAndHeaderByNumberOrHash(
 		// b.eth.blockchain.GetCanonicalHash(header.Number.Uint64()) != hash {
 		// 	return nil, nil, errors.New("hash is not currently canonical")
 		// }
-		return b.StateAndHeaderByNumber(ctx, rpc.BlockNumber(header.Number.Int64()))
+		return b.stateAndHeaderByNumber(ctx, rpc.BlockNumber(header.Number.Int64()), stateAt)
 	}
 	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
 }
@@ -410,7 +486,7 @@ func (b *backend) GetTransaction(
 // PendingBlockAndReceipts returns the pending block (equivalent to current block in Polaris)
 // and associated receipts.
 func (b *backend) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts) {
-	block, receipts := b.polar.miner.PendingBlockAndReceipts()
+	block, receipts, _ := b.pending()
 	// If the block is non-existent, return nil.
 	// This is to maintain parity with the behavior of the geth backend.
 	if block == nil {
@@ -422,6 +498,18 @@ func (b *backend) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts)
 	return block, receipts
 }
 
+// pending returns the pending block, its receipts and state from the pending provider of the host
+// chain, falling back to the pending block of the geth miner.
+func (b *backend) pending() (*ethtypes.Block, ethtypes.Receipts, state.StateDB) {
+	if b.polar.pending != nil {
+		if block, receipts, state := b.polar.pending.Pending(); block != nil {
+			return block, receipts, state
+		}
+	}
+	block, receipts := b.polar.miner.PendingBlockAndReceipts()
+	return block, receipts, nil
+}
+
 // GetReceipts returns the receipts for the given block hash.
 func (b *backend) GetReceipts(_ context.Context, hash common.Hash) (ethtypes.Receipts, error) {
 	return b.polar.blockchain.GetReceiptsByHash(hash), nil
@@ -451,7 +539,7 @@ func (b *backend) GetTd(_ context.Context, hash common.Hash) *big.Int {
 
 // GetEVM returns a new EVM to be used for simulating a transaction, estimating gas etc.
 func (b *backend) GetEVM(_ context.Context, msg *core.Message,
-	state state.StateDB, header *ethtypes.Header, vmConfig *vm.Config,
+	statedb state.StateDB, header *ethtypes.Header, vmConfig *vm.Config,
 	blockCtx *vm.BlockContext,
 ) *vm.EVM {
 	if vmConfig == nil {
@@ -462,11 +550,13 @@ func (b *backend) GetEVM(_ context.Context, msg *core.Message,
 	if blockCtx != nil {
 		context = *blockCtx
 	} else {
-		// TODO: we are hardcoding author to coinbase, this may be incorrect.
-		// TODO: Suggestion -> implement Engine.Author() and allow host chain to decide.
-		context = core.NewEVMBlockContext(header, b.polar.Blockchain(), &header.Coinbase)
+		// The author of the block is resolved by the consensus engine, which reports the
+		// coinbase (i.e. the block proposer) as the author of post-merge blocks.
+		context = core.NewEVMBlockContext(header, b.polar.Blockchain(), nil)
 	}
-	return vm.NewEVM(context, txContext, state, b.polar.blockchain.Config(),
+	// Run the precompiles that are active in the block of the EVM.
+	state.BindBlock(statedb, context.BlockNumber, context.Time)
+	return vm.NewEVM(context, txContext, statedb, b.polar.blockchain.Config(),
 		*vmConfig)
 }
 
@@ -474,9 +564,8 @@ func (b *backend) GetEVM(_ context.Context, msg *core.Message,
 func (b *backend) GetBlockContext(
 	_ context.Context, header *ethtypes.Header,
 ) *vm.BlockContext {
-	// TODO: we are hardcoding author to coinbase, this may be incorrect.
-	// TODO: Suggestion -> implement Engine.Author() and allow host chain to decide.
-	blockContext := core.NewEVMBlockContext(header, b.polar.Blockchain(), &header.Coinbase)
+	// The author of the block is resolved by the consensus engine.
+	blockContext := core.NewEVMBlockContext(header, b.polar.Blockchain(), nil)
 	return &blockContext
 }
 
@@ -524,6 +613,10 @@ func (b *backend) GetPoolTransaction(hash common.Hash) *ethtypes.Transaction {
 
 func (b *backend) GetPoolNonce(_ context.Context, addr common.Address) (uint64, error) {
 	nonce := b.polar.txPool.Nonce(addr)
+	// The pending state may be ahead of the txpool, which only resets on new heads.
+	if _, _, state := b.pending(); state != nil {
+		nonce = max(nonce, state.GetNonce(addr))
+	}
 	b.logger.Debug("called eth.rpc.backend.GetPoolNonce", "addr", addr, "nonce", nonce)
 	return nonce, nil
 }
@@ -595,13 +688,17 @@ func (b *backend) SubscribePendingLogsEvent(ch chan<- []*ethtypes.Log) event.Sub
 	return b.polar.miner.SubscribePendingLogs(ch)
 }
 
+// BloomStatus returns the section size and the number of sections of the bloom-bits index.
 func (b *backend) BloomStatus() (uint64, uint64) {
-	// TODO: Implement your code here
-	return 0, 0
+	return b.polar.blockchain.BloomStatus()
 }
 
-func (b *backend) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
-	// TODO: Implement your code here
+// ServiceFilter multiplexes the bloom bit retrievals of the given session onto the bloom
+// servicing goroutines.
+func (b *backend) ServiceFilter(_ context.Context, session *bloombits.MatcherSession) {
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.polar.bloomRequests)
+	}
 }
 
 // Version returns the current chain protocol version.
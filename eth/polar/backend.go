@@ -21,22 +21,27 @@
 package polar
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/berachain/polaris/eth/consensus"
 	"github.com/berachain/polaris/eth/core"
 	polarapi "github.com/berachain/polaris/eth/polar/api"
+	errorslib "github.com/berachain/polaris/lib/errors"
 
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
@@ -52,6 +57,15 @@ import (
 // abstracted away networking stack, by extension we will need to improve the registration
 // architecture.
 
+const (
+	// sideDBCache is the memory, in megabytes, of the cache of each of the databases that are
+	// kept besides the state of the host chain.
+	sideDBCache = 16
+	// sideDBHandles is the number of file handles of each of the databases that are kept besides
+	// the state of the host chain.
+	sideDBHandles = 16
+)
+
 var defaultEthConfig = ethconfig.Config{
 	SyncMode:           0,
 	FilterLogCacheSize: 0,
@@ -78,6 +92,7 @@ type Polaris struct {
 	// JSON-RPC APIs and the core pieces.
 	apiBackend APIBackend
 	syncStatus SyncStatusProvider
+	pending    PendingProvider
 
 	// engine represents the consensus engine for the backend.
 	engine consensus.Engine
@@ -85,16 +100,27 @@ type Polaris struct {
 	// filterSystem is the filter system that is used by the filter API.
 	// TODO: relocate
 	filterSystem *filters.FilterSystem
+
+	// bloomRequests is the channel receiving bloom data retrieval requests of the filter system.
+	bloomRequests chan chan *bloombits.Retrieval
+	// closeBloomHandler is closed to terminate the bloom bits servicing goroutines.
+	closeBloomHandler chan struct{}
+
+	// preimages is the database of the recorded SHA3 preimages, nil if recording is disabled.
+	preimages ethdb.Database
+	// blooms is the database of the bloom-bits index of the filter system.
+	blooms ethdb.Database
 }
 
-// New creates a new backend for the Polaris EVM.
+// New creates a new backend for the Polaris EVM. It returns an error if the side databases, the
+// txpool or the miner cannot be set up.
 func New(
 	config *Config,
 	host core.PolarisHostChain,
 	engine consensus.Engine,
 	stack executionLayerNode,
 	allowUnprotectedTxs bool,
-) *Polaris {
+) (*Polaris, error) {
 	if config.Miner.GasPrice == nil || config.Miner.GasPrice.Cmp(common.Big0) <= 0 {
 		log.Warn("Sanitizing invalid miner gas price",
 			"provided", config.Miner.GasPrice, "updated", ethconfig.Defaults.Miner.GasPrice)
@@ -102,7 +128,7 @@ func New(
 	}
 
 	if engine == nil {
-		engine = beacon.New(&consensus.DummyEthOne{})
+		engine = consensus.NewEngine()
 	}
 
 	pl := &Polaris{
@@ -110,7 +136,29 @@ func New(
 		host:       host,
 		engine:     engine,
 		blockchain: core.NewChain(host, &config.Chain, engine),
+
+		bloomRequests:     make(chan chan *bloombits.Retrieval),
+		closeBloomHandler: make(chan struct{}),
+	}
+
+	// Record the preimages computed by the EVM into their own database, if enabled.
+	var err error
+	if config.EnablePreimageRecording {
+		if pl.preimages, err = openSideDB(
+			config.PreimagesDatadir, "polaris/db/preimages/",
+		); err != nil {
+			return nil, errorslib.Wrap(err, "failed to open the preimages database")
+		}
+		pl.blockchain.EnablePreimageRecording(pl.preimages)
+	}
+
+	// Index the log blooms of the finalized blocks into their own database.
+	if pl.blooms, err = openSideDB(config.BloomsDatadir, "polaris/db/blooms/"); err != nil {
+		return nil, errors.Join(
+			errorslib.Wrap(err, "failed to open the blooms database"), pl.closeSideDBs(),
+		)
 	}
+	pl.blockchain.EnableBloomIndex(pl.blooms)
 
 	// Build the backend api object.
 	pl.apiBackend = NewAPIBackend(
@@ -121,19 +169,22 @@ func New(
 	// with development configs.
 	pl.config.SafetyMessage()
 
-	// For now, we only have a legacy pool, we will implement blob pool later.
+	// Setup the legacy pool and the blob pool.
+	poolChain := &txPoolChain{Blockchain: pl.blockchain}
 	legacyPool := legacypool.New(
-		pl.config.LegacyTxPool, pl.Blockchain(),
+		pl.config.LegacyTxPool, poolChain,
+	)
+	blobPool := blobpool.New(
+		pl.config.BlobPool, poolChain,
 	)
 
-	// Setup the transaction pool and attach the legacyPool.
-	var err error
+	// Setup the transaction pool and attach the subpools.
 	if pl.txPool, err = txpool.New(
 		new(big.Int).SetUint64(pl.config.LegacyTxPool.PriceLimit),
-		pl.blockchain,
-		[]txpool.SubPool{legacyPool},
+		poolChain,
+		[]txpool.SubPool{legacyPool, blobPool},
 	); err != nil {
-		panic(err)
+		return nil, errors.Join(err, pl.closeSideDBs())
 	}
 
 	// Setup the miner, we use a dummy isLocal function, since it is not used.
@@ -143,7 +194,7 @@ func New(
 	)
 
 	if err = pl.miner.SetExtra(pl.config.Miner.ExtraData); err != nil {
-		panic(err)
+		return nil, errors.Join(err, pl.txPool.Close(), pl.closeSideDBs())
 	}
 
 	// Register the backend on the node
@@ -152,19 +203,43 @@ func New(
 
 	// Register the filter API separately in order to get access to the filterSystem
 	pl.filterSystem = utils.RegisterFilterAPI(stack, pl.apiBackend, &defaultEthConfig)
-	return pl
+	return pl, nil
 }
 
 // Start implements node.Lifecycle, starting all internal goroutines needed by the
 // Polaris protocol implementation.
 func (pl *Polaris) Start() error {
+	// Start the bloom bits servicing goroutines.
+	pl.startBloomHandlers()
 	return nil
 }
 
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Polaris protocol.
 func (pl *Polaris) Stop() error {
-	return nil
+	close(pl.closeBloomHandler)
+	return pl.closeSideDBs()
+}
+
+// closeSideDBs closes the side databases which have been opened.
+func (pl *Polaris) closeSideDBs() error {
+	var errs []error
+	if pl.preimages != nil {
+		errs = append(errs, pl.preimages.Close())
+	}
+	if pl.blooms != nil {
+		errs = append(errs, pl.blooms.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// openSideDB opens a database, which is kept besides the state of the host chain, in the given
+// directory, or in memory if the directory is empty. The namespace prefixes its metrics.
+func openSideDB(datadir, namespace string) (ethdb.Database, error) {
+	if datadir == "" {
+		return rawdb.NewMemoryDatabase(), nil
+	}
+	return rawdb.NewLevelDBDatabase(datadir, sideDBCache, sideDBHandles, namespace, false)
 }
 
 // APIs return the collection of RPC services the polar package offers.
@@ -175,6 +250,11 @@ func (pl *Polaris) APIs() []rpc.API {
 
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
+		{
+			// NOTE: registered after the go-ethereum APIs, so that these methods take precedence.
+			Namespace: "eth",
+			Service:   polarapi.NewEthAPI(pl.apiBackend),
+		},
 		{
 			Namespace: "net",
 			Service:   polarapi.NewNetAPI(pl.apiBackend),
@@ -186,11 +266,17 @@ func (pl *Polaris) APIs() []rpc.API {
 			),
 		},
 		{
-			// NOTE: endpoints that require tracing "bad blocks" are currently not supported
-			// (debug_traceBadBlock, debug_intermediateRoots, debug_standardTraceBadBlockToFile)
+			// NOTE: endpoints that trace "bad blocks" (debug_traceBadBlock,
+			// debug_intermediateRoots, debug_standardTraceBadBlockToFile) read the bad blocks
+			// kept by the blockchain through the `ChainDb` of the backend.
 			Namespace: "debug",
 			Service:   tracers.NewAPI(pl.apiBackend),
 		},
+		{
+			// NOTE: registered after the go-ethereum APIs, so that these methods take precedence.
+			Namespace: "debug",
+			Service:   polarapi.NewDebugAPI(pl.apiBackend),
+		},
 	}...)
 }
 
@@ -201,6 +287,13 @@ func (pl *Polaris) RegisterSyncStatusProvider(
 	pl.syncStatus = syncStatus
 }
 
+// RegisterPendingProvider registers a provider of the pending block.
+func (pl *Polaris) RegisterPendingProvider(
+	pending PendingProvider,
+) {
+	pl.pending = pending
+}
+
 // Host returns the Polaris host chain.
 func (pl *Polaris) Host() core.PolarisHostChain {
 	return pl.host
@@ -22,11 +22,14 @@ package polar
 
 import (
 	"math/big"
+	"runtime"
 	"time"
 
+	"github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/params"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -43,6 +46,9 @@ const (
 	// DO NOT USE IN PRODUCTION.
 	// 0xf8637fa70e8e329ecb8463b788d96914f8cfe191d15ae36f161227629e3f5693.
 	developmentCoinbase = "0xAf15f95bed0D3913a29092Fd7837451Ce4de64D3"
+
+	// rpcCallQueueDepthDefault is the default number of eth-call variants waiting for a worker.
+	rpcCallQueueDepthDefault = 1024
 )
 
 // DefaultConfig returns the default JSON-RPC config.
@@ -57,15 +63,23 @@ func DefaultConfig() *Config {
 	legacyPool.NoLocals = true
 	legacyPool.PriceLimit = 8 // to handle the low base fee.
 	legacyPool.Journal = ""
-
+	precompiles := precompile.Upgrades{
+		GasSchedulesBlock: big.NewInt(0),
+		NonPayableBlock:   big.NewInt(0),
+	}
 	return &Config{
 		Chain:         *params.DefaultChainConfig,
+		Precompiles:   precompiles,
 		Miner:         minerCfg,
 		GPO:           gpoConfig,
 		LegacyTxPool:  legacyPool,
+		BlobPool:      blobpool.DefaultConfig,
 		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
 		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
 		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+
+		RPCCallWorkers:    runtime.NumCPU(),
+		RPCCallQueueDepth: rpcCallQueueDepthDefault,
 	}
 }
 
@@ -84,6 +98,9 @@ type Config struct {
 	// The chain configuration to use.
 	Chain ethparams.ChainConfig
 
+	// The upgrades of the stateful precompiles, which are part of the chain configuration.
+	Precompiles precompile.Upgrades
+
 	// Mining options
 	Miner miner.Config
 
@@ -93,13 +110,35 @@ type Config struct {
 	// Transaction pool options
 	LegacyTxPool legacypool.Config
 
+	// Blob transaction pool options
+	BlobPool blobpool.Config
+
 	// RPCGasCap is the global gas cap for eth-call variants.
 	RPCGasCap uint64
 
 	// RPCEVMTimeout is the global timeout for eth-call.
 	RPCEVMTimeout time.Duration
 
+	// RPCCallWorkers is the number of eth-call variants executed concurrently. It defaults to
+	// the number of CPUs if not positive.
+	RPCCallWorkers int
+
+	// RPCCallQueueDepth is the number of eth-call variants waiting for a worker, beyond which
+	// new calls are rejected.
+	RPCCallQueueDepth int
+
 	// RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64
+
+	// EnablePreimageRecording enables the recording of the SHA3 preimages computed by the EVM.
+	EnablePreimageRecording bool
+
+	// PreimagesDatadir is the directory of the database of the recorded preimages. The
+	// preimages are kept in memory if it is empty.
+	PreimagesDatadir string
+
+	// BloomsDatadir is the directory of the database of the bloom-bits index of the filter
+	// system. The index is kept in memory if it is empty.
+	BloomsDatadir string
 }
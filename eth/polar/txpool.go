@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/core/state"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// txPoolChain wraps the blockchain for the transaction subpools.
+//
+// NOTE: the blockchain does not serve the state after the head block by root (see
+// `core.ErrStateAtHead`), while the blob pool resets onto the head root without falling back to
+// the state at a block number. The head root is therefore resolved to the state that execution
+// on top of the head block observes.
+type txPoolChain struct {
+	core.Blockchain
+}
+
+// StateAt returns the state at the given root, resolving the root of the current head block to
+// the state at the beginning of the next block.
+func (c *txPoolChain) StateAt(root common.Hash) (state.StateDB, error) {
+	if head := c.CurrentBlock(); head != nil && head.Root == root {
+		return c.StateAtBlockNumber(head.Number.Uint64() + 1)
+	}
+	return c.Blockchain.StateAt(root)
+}